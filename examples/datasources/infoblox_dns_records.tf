@@ -0,0 +1,14 @@
+data "infoblox_dns_records" "app01" {
+  zone = "example.com"
+  name = "app01"
+}
+
+data "infoblox_dns_records" "app_addresses" {
+  zone       = "example.com"
+  name_regex = "^app[0-9]+$"
+  types      = ["A", "AAAA"]
+}
+
+output "app01_records" {
+  value = [for r in data.infoblox_dns_records.app01.records : "${r.type} ${r.rdata}"]
+}
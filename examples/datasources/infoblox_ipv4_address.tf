@@ -0,0 +1,14 @@
+data "infoblox_ipv4_address" "servers_net" {
+  network = "10.0.0.0/24"
+  status  = "UNUSED"
+}
+
+// the addresses which are neither used in NIOS nor found in use in the network
+output "free_addresses" {
+  value = [for a in data.infoblox_ipv4_address.servers_net.addresses : a.ip_address if !a.discovered && !a.is_conflict]
+}
+
+data "infoblox_ipv6_address" "leases" {
+  network = "2001:db8:abcd:12::/64"
+  types   = ["LEASE"]
+}
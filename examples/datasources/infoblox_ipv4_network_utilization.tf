@@ -0,0 +1,25 @@
+data "infoblox_ipv4_network_utilization" "container" {
+  cidr = "10.0.0.0/16"
+}
+
+// do not allocate from the network container when it is almost full
+resource "infoblox_ipv4_network" "net" {
+  parent_cidr         = data.infoblox_ipv4_network_utilization.container.cidr
+  allocate_prefix_len = 24
+
+  lifecycle {
+    precondition {
+      condition     = data.infoblox_ipv4_network_utilization.container.utilization < 90
+      error_message = "Network container 10.0.0.0/16 is over 90% used."
+    }
+  }
+}
+
+data "infoblox_ipv4_range_utilization" "pool" {
+  start_addr = "10.0.1.100"
+  end_addr   = "10.0.1.199"
+}
+
+output "pool_dhcp_utilization" {
+  value = data.infoblox_ipv4_range_utilization.pool.dhcp_utilization
+}
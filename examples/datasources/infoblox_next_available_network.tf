@@ -0,0 +1,22 @@
+// the next two /24 networks of the container, without allocating them
+data "infoblox_next_available_network" "candidates" {
+  parent_cidr         = "10.0.0.0/16"
+  allocate_prefix_len = 24
+  num                 = 2
+  exclude             = ["10.0.1.0/24"]
+}
+
+// the next /64 network of the IPv6 container with the extensible attributes
+data "infoblox_next_available_network" "by_ea" {
+  filter_params = jsonencode({
+    "*Site" = "Turkey"
+  })
+  ip_address_type     = "IPV6"
+  allocate_prefix_len = 64
+}
+
+// the next five IP addresses of the network
+data "infoblox_next_available_ip" "candidates" {
+  cidr = "10.0.0.0/24"
+  num  = 5
+}
@@ -0,0 +1,13 @@
+data "infoblox_zone_file" "example" {
+  fqdn = "example.com"
+  view = "default"
+}
+
+resource "local_file" "example_zone" {
+  filename = "${path.module}/example.com.zone"
+  content = data.infoblox_zone_file.example.content
+}
+
+output "example_mx_records" {
+  value = [for r in data.infoblox_zone_file.example.records : r.rdata if r.type == "MX"]
+}
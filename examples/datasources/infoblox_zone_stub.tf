@@ -0,0 +1,26 @@
+resource "infoblox_zone_stub" "stub_zone" {
+  fqdn = "partner.example.org"
+  stub_from {
+    name = "ns1.partner.example.org"
+    address = "10.0.0.1"
+  }
+  ext_attrs = jsonencode({
+    "Site" = "Antarctica"
+  })
+}
+
+// accessing Zone Stub by specifying fqdn, view and extra attribute Site
+data "infoblox_zone_stub" "data_zone_stub" {
+  filters = {
+    fqdn = "partner.example.org"
+    view = "default"
+    "*Site" = "Antarctica"
+  }
+  // This is just to ensure that the zone has been be created
+  depends_on = [infoblox_zone_stub.stub_zone]
+}
+
+// returns matching Zone Stub with fqdn and view, if any
+output "zone_stub_data" {
+  value = data.infoblox_zone_stub.data_zone_stub
+}
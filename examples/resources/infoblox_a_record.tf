@@ -41,3 +41,33 @@ resource "infoblox_a_record" "recordA" {
   })
   network_view = "custom"
 }
+
+// static A-record along with its PTR-record, the reverse zone must exist
+resource "infoblox_zone_auth" "rev_zone" {
+  fqdn        = "10.0.0.0/24"
+  zone_format = "IPV4"
+}
+
+resource "infoblox_a_record" "rec_with_ptr" {
+  fqdn       = "static3.example1.org"
+  ip_addr    = "10.0.0.12"
+  create_ptr = true
+  comment    = "the PTR-record gets the same comment"
+  depends_on = [infoblox_zone_auth.rev_zone]
+}
+
+// dynamic A-record allocated from a DHCP range, skipping the addresses reserved for the gateways
+resource "infoblox_a_record" "rec_range" {
+  fqdn        = "server1.example1.org"
+  range_start = "10.0.0.100"
+  range_end   = "10.0.0.150"
+  exclude     = ["10.0.0.100", "10.0.0.101"]
+}
+
+// dynamic A-record allocated from the DHCP range with the given extensible attributes
+resource "infoblox_a_record" "rec_range_ea" {
+  fqdn = "appliance1.example1.org"
+  range_filter_params = jsonencode({
+    "*Purpose" = "Appliances"
+  })
+}
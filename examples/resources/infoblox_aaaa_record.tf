@@ -54,4 +54,16 @@ resource "infoblox_aaaa_record" "recordaaaa" {
   })
   network_view = "custom"
   dns_view     = "default.custom"
-}
\ No newline at end of file
+}
+// static AAAA-record along with its PTR-record, the reverse zone must exist
+resource "infoblox_zone_auth" "rev_zone6" {
+  fqdn        = "2000::/64"
+  zone_format = "IPV6"
+}
+
+resource "infoblox_aaaa_record" "rec_with_ptr" {
+  fqdn       = "static3.example1.org"
+  ipv6_addr  = "2000::12"
+  create_ptr = true
+  depends_on = [infoblox_zone_auth.rev_zone6]
+}
@@ -22,3 +22,41 @@ resource "infoblox_dns_view" "view3" {
     "Site" = "Cal Site"
   })
 }
+
+// split-horizon DNS: internal clients are served by the first view,
+// all the other clients by the second one
+resource "infoblox_dns_view" "internal" {
+  name = "internal"
+  order = 0
+  match_clients {
+    address = "10.0.0.0/8"
+  }
+  match_clients {
+    named_acl = "trusted-hosts"
+  }
+  recursion = true
+  forwarders = ["10.1.1.1", "10.1.1.2"]
+  forward_only = true
+  dns64 {
+    groups = ["default"]
+  }
+}
+
+resource "infoblox_dns_view" "external" {
+  name = "external"
+  order = 1
+  match_clients {
+    address = "Any"
+  }
+  recursion = false
+  lame_ttl = 600
+  blacklist {
+    rulesets = ["malware-domains"]
+    action = "REDIRECT"
+    redirect_addresses = ["10.3.3.3"]
+  }
+  nxdomain_redirect {
+    addresses = ["10.2.2.2"]
+    ttl = 120
+  }
+}
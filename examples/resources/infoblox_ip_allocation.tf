@@ -104,4 +104,13 @@ resource "infoblox_ip_allocation" "allocation9" {
   ext_attrs = jsonencode({
     "Tenant ID" = "tenant_3261798"
   })
-}
\ No newline at end of file
+}
+
+// dynamic allocation of an IPv4 address from the DHCP range with the given extensible attributes
+resource "infoblox_ip_allocation" "allocation_range" {
+  fqdn = "appliance2.test.com"
+  range_filter_params = jsonencode({
+    "*Purpose" = "Appliances"
+  })
+  exclude = ["10.0.0.200"]
+}
@@ -53,3 +53,39 @@ resource "infoblox_ipv4_network" "ipv4_network2" {
     Location = "Badrinath"
   })
 }
+
+// IPv4 network served by a DHCP member, with its own DHCP options, DDNS and PXE boot settings
+resource "infoblox_ipv4_network" "dhcp_network" {
+  cidr         = "10.2.0.0/24"
+  gateway      = "10.2.0.1"
+  dhcp_members = ["dhcp1.example.com"]
+  dhcp_options {
+    domain_name_servers = ["10.2.0.2", "10.2.0.3"]
+    domain_name         = "example.com"
+    lease_time          = 43200
+  }
+  ddns {
+    enable      = true
+    domain_name = "dyn.example.com"
+  }
+  pxe {
+    bootfile   = "pxelinux.0"
+    nextserver = "10.2.0.10"
+  }
+}
+
+// IPv4 network created out of a network template, with its gateway and reserved IP addresses
+resource "infoblox_ipv4_network" "branch_network" {
+  cidr       = "10.3.0.0/24"
+  template   = "branch-subnet"
+  gateway    = "10.3.0.1"
+  reserve_ip = 2
+}
+
+// IPv4 network whose DHCP ranges are served by a DHCP failover association
+resource "infoblox_ipv4_network" "failover_network" {
+  cidr                      = "10.4.0.0/24"
+  template                  = "branch-subnet"
+  dhcp_members              = ["dhcp1.example.com", "dhcp2.example.com"]
+  dhcp_failover_association = "branch-failover"
+}
@@ -55,3 +55,17 @@ resource "infoblox_ipv6_network" "ipv6network2" {
   })
   object = "network"
 }
+
+// IPv6 network served by a DHCP member, with its own DHCPv6 options and DDNS settings
+resource "infoblox_ipv6_network" "dhcp_network" {
+  cidr         = "2002:1f93:0:5::/64"
+  dhcp_members = ["dhcp1.example.com"]
+  dhcp_options {
+    domain_name_servers = ["2002:1f93:0:5::53"]
+    domain_name         = "example.com"
+    lease_time          = 43200
+  }
+  ddns {
+    enable = true
+  }
+}
@@ -0,0 +1,36 @@
+resource "infoblox_named_acl" "internal" {
+  name    = "internal-clients"
+  comment = "internal networks"
+  access_list {
+    address    = "10.1.1.1"
+    permission = "DENY"
+  }
+  access_list {
+    address = "10.0.0.0/8"
+  }
+  access_list {
+    address = "2001:db8::/32"
+  }
+  ext_attrs = jsonencode({
+    "Site" = "Antarctica"
+  })
+}
+
+resource "infoblox_named_acl" "transfer" {
+  name = "transfer-clients"
+  access_list {
+    tsig_key_name = "transfer-key"
+    tsig_key      = "X4oRe92t54I+T98NdQpV2w=="
+    tsig_key_alg  = "HMAC-SHA256"
+  }
+  access_list {
+    named_acl = infoblox_named_acl.internal.name
+  }
+}
+
+resource "infoblox_zone_auth" "zone" {
+  fqdn = "example.com"
+  allow_query {
+    named_acl = infoblox_named_acl.internal.name
+  }
+}
@@ -0,0 +1,47 @@
+resource "infoblox_ipv4_range_template" "dynamic" {
+  name                    = "branch-dynamic"
+  comment                 = "DHCP clients of a branch"
+  offset                  = 100
+  number_of_addresses     = 100
+  server_association_type = "FAILOVER"
+  failover_association    = "branch-failover"
+  options {
+    name  = "dhcp-lease-time"
+    value = "43200"
+  }
+}
+
+resource "infoblox_ipv4_fixed_address_template" "printers" {
+  name                = "branch-printers"
+  offset              = 10
+  number_of_addresses = 5
+}
+
+resource "infoblox_ipv4_network_template" "branch" {
+  name                    = "branch-subnet"
+  comment                 = "standard branch subnet"
+  prefix_length           = 24
+  range_templates         = [infoblox_ipv4_range_template.dynamic.name]
+  fixed_address_templates = [infoblox_ipv4_fixed_address_template.printers.name]
+  dhcp_members            = ["dhcp1.example.com"]
+  options {
+    name  = "domain-name-servers"
+    value = "10.0.0.2,10.0.0.3"
+  }
+  ext_attrs = jsonencode({
+    "Site" = "Branch"
+  })
+}
+
+resource "infoblox_ipv6_network_template" "branch" {
+  name          = "branch-subnet-v6"
+  prefix_length = 64
+  dhcp_members  = ["dhcp1.example.com"]
+}
+
+resource "infoblox_ipv4_network" "branch1" {
+  cidr       = "10.3.0.0/24"
+  template   = infoblox_ipv4_network_template.branch.name
+  gateway    = "10.3.0.1"
+  reserve_ip = 2
+}
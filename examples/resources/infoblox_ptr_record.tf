@@ -49,3 +49,11 @@ resource "infoblox_ptr_record" "rec6_forward" {
   ptrdname = "example1.org"
   record_name = "www.example1.org"
 }
+
+// PTR-record allocated from a DHCP range
+resource "infoblox_ptr_record" "rec7_range" {
+  ptrdname    = "server2.example1.org"
+  range_start = "10.0.0.100"
+  range_end   = "10.0.0.150"
+  exclude     = ["10.0.0.100"]
+}
@@ -0,0 +1,64 @@
+// shared record group, minimal set of parameters
+resource "infoblox_shared_record_group" "grp1" {
+  name = "empty-group"
+}
+
+// shared record group associated with two zones
+resource "infoblox_shared_record_group" "vanity" {
+  name    = "vanity-mail"
+  comment = "mail settings shared by vanity zones"
+  zone_associations {
+    fqdn = "vanity1.example.org"
+  }
+  zone_associations {
+    fqdn = "vanity2.example.org"
+    view = "nondefault_dnsview1"
+  }
+  ext_attrs = jsonencode({
+    "Site" = "HQ"
+  })
+}
+
+// shared records served by all the zones associated with the group
+resource "infoblox_shared_record_a" "www" {
+  shared_record_group = infoblox_shared_record_group.vanity.name
+  name                = "www"
+  ip_addr             = "10.0.0.10"
+}
+
+resource "infoblox_shared_record_aaaa" "www" {
+  shared_record_group = infoblox_shared_record_group.vanity.name
+  name                = "www"
+  ipv6_addr           = "2001:db8::10"
+}
+
+resource "infoblox_shared_record_cname" "webmail" {
+  shared_record_group = infoblox_shared_record_group.vanity.name
+  name                = "webmail"
+  canonical           = "mail.example.com"
+}
+
+resource "infoblox_shared_record_mx" "mx" {
+  shared_record_group = infoblox_shared_record_group.vanity.name
+  mail_exchanger      = "mx1.example.com"
+  preference          = 10
+}
+
+resource "infoblox_shared_record_srv" "sip" {
+  shared_record_group = infoblox_shared_record_group.vanity.name
+  name                = "_sip._udp"
+  priority            = 10
+  weight              = 5
+  port                = 5060
+  target              = "sip.example.com"
+}
+
+resource "infoblox_shared_record_txt" "spf" {
+  shared_record_group = infoblox_shared_record_group.vanity.name
+  text                = "v=spf1 include:example.com -all"
+  ttl                 = 3600
+  comment             = "SPF policy"
+  ext_attrs = jsonencode({
+    "Site" = "HQ"
+  })
+}
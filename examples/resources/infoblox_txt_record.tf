@@ -23,3 +23,12 @@ resource "infoblox_txt_record" "rec3" {
     "Location" = "65.8665701230204, -37.00791763398113"
   })
 }
+
+// TXT-Record with the value split into character strings explicitly
+resource "infoblox_txt_record" "rec4" {
+  fqdn = "selector1._domainkey.example.org"
+  text_strings = [
+    "v=DKIM1; k=rsa; ",
+    "p=MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA",
+  ]
+}
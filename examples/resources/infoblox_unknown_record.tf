@@ -0,0 +1,42 @@
+// SSHFP-record, minimal set of parameters
+resource "infoblox_unknown_record" "sshfp" {
+  fqdn        = "host1.example.org"
+  record_type = "SSHFP"
+  subfield_values {
+    field_type  = "B"
+    field_value = "1" // algorithm: RSA
+  }
+  subfield_values {
+    field_type  = "B"
+    field_value = "1" // fingerprint type: SHA-1
+  }
+  subfield_values {
+    field_type  = "X"
+    field_value = "123456789ABCDEF67890123456789ABCDEF67890"
+  }
+}
+
+// URI-record, all the parameters
+resource "infoblox_unknown_record" "uri" {
+  dns_view    = "nondefault_dnsview1"
+  fqdn        = "_ftp._tcp.example2.org"
+  record_type = "URI"
+  subfield_values {
+    field_type  = "S"
+    field_value = "10" // priority
+  }
+  subfield_values {
+    field_type  = "S"
+    field_value = "1" // weight
+  }
+  subfield_values {
+    field_type  = "T"
+    field_value = "ftp://ftp1.example.com/public"
+  }
+  ttl     = 300
+  disable = false
+  comment = "example URI record"
+  ext_attrs = jsonencode({
+    "Location" = "65.8665701230204, -37.00791763398113"
+  })
+}
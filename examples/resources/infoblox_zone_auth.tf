@@ -45,3 +45,52 @@ resource "infoblox_zone_auth" "zone3" {
     Location = "Random TF location"
   })
 }
+
+//signed zone with custom DNSSEC key parameters
+resource "infoblox_zone_auth" "zone4" {
+  fqdn = "signed.example.com"
+  view = "default"
+  ns_group = "nsgroup1"
+  dnssec_signed = true
+  dnssec_key_params {
+    ksk_algorithms {
+      algorithm = "RSASHA256"
+      size = 2048
+    }
+    zsk_algorithms {
+      algorithm = "RSASHA256"
+      size = 1024
+    }
+    next_secure_type = "NSEC3"
+  }
+}
+
+//zone with access control lists
+resource "infoblox_zone_auth" "zone5" {
+  fqdn = "acl.example.com"
+  view = "default"
+  allow_transfer {
+    address = "10.0.0.0/24"
+  }
+  allow_transfer {
+    tsig_key_name = "transfer-key"
+    tsig_key = "X4oRe92t54I+T98NdQpV2w=="
+  }
+  allow_update {
+    address = "10.0.0.10"
+    permission = "ALLOW"
+  }
+}
+
+//zone served by the name servers assigned directly, without a name server group
+resource "infoblox_zone_auth" "zone6" {
+  fqdn = "servers.example.com"
+  view = "default"
+  grid_primary {
+    name = "infoblox.localdomain"
+  }
+  external_secondaries {
+    name = "ns2.example.org"
+    address = "10.0.0.2"
+  }
+}
@@ -0,0 +1,26 @@
+resource "infoblox_zone_auth" "legacy" {
+  fqdn = "legacy.example.com"
+}
+
+// creating the records of a legacy BIND zone file in the zone
+resource "infoblox_zone_import" "legacy" {
+  zone = infoblox_zone_auth.legacy.fqdn
+  view = "default"
+  content = file("${path.module}/legacy.example.com.zone")
+  ext_attrs = jsonencode({
+    "Site" = "Migrated from BIND"
+  })
+}
+
+// the content may be given inline as well
+resource "infoblox_zone_import" "inline" {
+  zone = infoblox_zone_auth.legacy.fqdn
+  content = <<-EOT
+    $TTL 3600
+    www   300  IN  A      10.0.0.10
+               IN  AAAA   2001:db8::10
+    ftp        IN  CNAME  www
+    @          IN  MX     10 mail
+    mail       IN  A      10.0.0.20
+  EOT
+}
@@ -0,0 +1,69 @@
+// locally maintained response policy zone
+resource "infoblox_zone_rp" "rpz" {
+  fqdn         = "rpz.example.org"
+  rpz_severity = "CRITICAL"
+  grid_primary {
+    name = "infoblox.localdomain"
+  }
+  comment = "DNS firewall maintained by the security team"
+  ext_attrs = jsonencode({
+    "Site" = "Antarctica"
+  })
+}
+
+// response policy zone transferred from an RPZ feed
+resource "infoblox_zone_rp" "feed" {
+  fqdn     = "feed.rpz.example.org"
+  rpz_type = "FEED"
+  external_primaries {
+    name    = "rpz.feed-provider.example.com"
+    address = "192.0.2.10"
+  }
+  grid_secondaries {
+    name = "infoblox.localdomain"
+  }
+}
+
+resource "infoblox_rpz_rule" "block_nxdomain" {
+  name    = "malware.example.com"
+  rp_zone = infoblox_zone_rp.rpz.fqdn
+  action  = "BLOCK_NXDOMAIN"
+}
+
+resource "infoblox_rpz_rule" "block_nodata" {
+  name    = "tracker.example.com"
+  rp_zone = infoblox_zone_rp.rpz.fqdn
+  action  = "BLOCK_NODATA"
+}
+
+resource "infoblox_rpz_rule" "passthru" {
+  name    = "good.example.com"
+  rp_zone = infoblox_zone_rp.rpz.fqdn
+  action  = "PASSTHRU"
+}
+
+resource "infoblox_rpz_rule" "substitute" {
+  name            = "phishing.example.com"
+  rp_zone         = infoblox_zone_rp.rpz.fqdn
+  action          = "SUBSTITUTE"
+  substitute_name = "walled-garden.example.org"
+}
+
+resource "infoblox_rpz_rule" "client_passthru" {
+  name    = "10.1.1.0/24"
+  rp_zone = infoblox_zone_rp.rpz.fqdn
+  trigger = "CLIENT_IP_ADDRESS"
+  action  = "PASSTHRU"
+}
+
+resource "infoblox_rpz_rule_a" "sinkhole" {
+  name     = "botnet.example.com"
+  rp_zone  = infoblox_zone_rp.rpz.fqdn
+  ipv4addr = "10.0.0.53"
+}
+
+resource "infoblox_rpz_rule_txt" "notice" {
+  name    = "botnet.example.com"
+  rp_zone = infoblox_zone_rp.rpz.fqdn
+  text    = "blocked by policy"
+}
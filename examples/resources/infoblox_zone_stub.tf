@@ -0,0 +1,40 @@
+//stub zone, with minimum set of parameters
+resource "infoblox_zone_stub" "stub_min" {
+  fqdn = "partner.example.org"
+  stub_from {
+    name = "ns1.partner.example.org"
+    address = "10.0.0.1"
+  }
+}
+
+//stub zone with full set of parameters
+resource "infoblox_zone_stub" "stub_full" {
+  fqdn = "partner2.example.org"
+  view = "nondefault_view"
+  stub_from {
+    name = "ns1.partner2.example.org"
+    address = "10.0.0.1"
+  }
+  stub_from {
+    name = "ns2.partner2.example.org"
+    address = "10.0.0.2"
+  }
+  stub_members {
+    name = "infoblox.localdomain"
+  }
+  disable_forwarding = true
+  comment = "stub zone of the partner"
+  ext_attrs = jsonencode({
+    "Site" = "Antarctica"
+  })
+}
+
+//reverse stub zone
+resource "infoblox_zone_stub" "stub_reverse" {
+  fqdn = "10.20.0.0/24"
+  zone_format = "IPV4"
+  stub_from {
+    name = "ns1.partner.example.org"
+    address = "10.0.0.1"
+  }
+}
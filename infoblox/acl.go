@@ -0,0 +1,261 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+const (
+	aclPermissionAllow = "ALLOW"
+	aclPermissionDeny  = "DENY"
+
+	aclStructAddress = "addressac"
+	aclStructTsig    = "tsigac"
+
+	namedAclObjType = "namedacl"
+)
+
+// aclEntrySchema is the schema of an access control entry, which is either
+// an IP address or network (or 'Any'), a TSIG key or a reference to a named ACL.
+var aclEntrySchema = map[string]*schema.Schema{
+	"address": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "The IPv4/IPv6 address or network in CIDR format, or 'Any', the entry applies to.",
+	},
+	"permission": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      aclPermissionAllow,
+		ValidateFunc: validation.StringInSlice([]string{aclPermissionAllow, aclPermissionDeny}, false),
+		Description:  "The permission of the entry: ALLOW or DENY; it is not used for TSIG key entries.",
+	},
+	"tsig_key_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "The name of the TSIG key the entry applies to.",
+	},
+	"tsig_key": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Sensitive:   true,
+		Description: "The value of the TSIG key, in Base64 format.",
+	},
+	"tsig_key_alg": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "HMAC-MD5",
+		ValidateFunc: validation.StringInSlice([]string{"HMAC-MD5", "HMAC-SHA256"}, false),
+		Description:  "The algorithm of the TSIG key: HMAC-MD5 or HMAC-SHA256.",
+	},
+	"use_tsig_key_name": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Determines whether the TSIG key is referenced by its name only, the key must be defined on the Grid then.",
+	},
+	"named_acl": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "The name of the named ACL the entry refers to.",
+	},
+}
+
+// aclSchema returns the schema of an ordered list of access control entries.
+func aclSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: aclEntrySchema,
+		},
+	}
+}
+
+// aclComputedSchema returns the schema of a list of access control entries, for data sources.
+func aclComputedSchema(description string) *schema.Schema {
+	entry := make(map[string]*schema.Schema, len(aclEntrySchema))
+	for k, v := range aclEntrySchema {
+		entry[k] = &schema.Schema{
+			Type:        v.Type,
+			Computed:    true,
+			Sensitive:   v.Sensitive,
+			Description: v.Description,
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: entry,
+		},
+	}
+}
+
+// aclEntry is an access control entry in the form NIOS accepts it:
+// the type of the entry is defined by its '_struct' field.
+type aclEntry struct {
+	Struct         string `json:"_struct"`
+	Address        string `json:"address,omitempty"`
+	Permission     string `json:"permission,omitempty"`
+	TsigKey        string `json:"tsig_key,omitempty"`
+	TsigKeyAlg     string `json:"tsig_key_alg,omitempty"`
+	TsigKeyName    string `json:"tsig_key_name,omitempty"`
+	UseTsigKeyName bool   `json:"use_tsig_key_name,omitempty"`
+}
+
+// getNamedAclRef returns the reference of the named ACL with the given name.
+func getNamedAclRef(connector ibclient.IBConnector, name string) (string, error) {
+	var res []ibclient.Namedacl
+	sf := map[string]string{
+		"name": name,
+	}
+	err := connector.GetObject(&ibclient.Namedacl{}, "", ibclient.NewQueryParams(false, sf), &res)
+	if err != nil {
+		return "", fmt.Errorf("failed to get named ACL '%s': %w", name, err)
+	}
+	if len(res) == 0 {
+		return "", fmt.Errorf("named ACL '%s' not found", name)
+	}
+
+	return res[0].Ref, nil
+}
+
+// namedAclNameFromRef extracts the name of a named ACL from its reference.
+func namedAclNameFromRef(ref string) string {
+	if idx := strings.LastIndex(ref, ":"); idx >= 0 {
+		return ref[idx+1:]
+	}
+	return ref
+}
+
+// convertAclFromInterface converts the access control entries of a resource into the list NIOS accepts:
+// a named ACL is referenced by its WAPI reference, the other entries are 'addressac' and 'tsigac' structs.
+func convertAclFromInterface(connector ibclient.IBConnector, entries []interface{}) ([]interface{}, error) {
+	res := make([]interface{}, 0, len(entries))
+	for _, e := range entries {
+		entry, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("an access control entry must not be empty")
+		}
+
+		address := entry["address"].(string)
+		tsigKeyName := entry["tsig_key_name"].(string)
+		namedAcl := entry["named_acl"].(string)
+
+		specified := 0
+		for _, v := range []string{address, tsigKeyName, namedAcl} {
+			if v != "" {
+				specified++
+			}
+		}
+		if specified != 1 {
+			return nil, fmt.Errorf(
+				"exactly one of 'address', 'tsig_key_name' and 'named_acl' must be set in an access control entry")
+		}
+
+		switch {
+		case namedAcl != "":
+			ref, err := getNamedAclRef(connector, namedAcl)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, ref)
+		case tsigKeyName != "":
+			res = append(res, &aclEntry{
+				Struct:         aclStructTsig,
+				TsigKeyName:    tsigKeyName,
+				TsigKey:        entry["tsig_key"].(string),
+				TsigKeyAlg:     entry["tsig_key_alg"].(string),
+				UseTsigKeyName: entry["use_tsig_key_name"].(bool),
+			})
+		default:
+			res = append(res, &aclEntry{
+				Struct:     aclStructAddress,
+				Address:    address,
+				Permission: entry["permission"].(string),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// convertAclToInterface is the reverse of convertAclFromInterface.
+func convertAclToInterface(entries []interface{}) ([]interface{}, error) {
+	res := make([]interface{}, 0, len(entries))
+	for _, e := range entries {
+		item := map[string]interface{}{
+			"address":           "",
+			"permission":        aclPermissionAllow,
+			"tsig_key_name":     "",
+			"tsig_key":          "",
+			"tsig_key_alg":      "HMAC-MD5",
+			"use_tsig_key_name": false,
+			"named_acl":         "",
+		}
+
+		if ref, ok := e.(string); ok {
+			item["named_acl"] = namedAclNameFromRef(ref)
+			res = append(res, item)
+			continue
+		}
+
+		var entry aclEntry
+		if err := convertWapiObject(e, &entry); err != nil {
+			return nil, fmt.Errorf("unexpected access control entry: %w", err)
+		}
+		if entry.TsigKeyName != "" {
+			item["tsig_key_name"] = entry.TsigKeyName
+			item["tsig_key"] = entry.TsigKey
+			item["use_tsig_key_name"] = entry.UseTsigKeyName
+			if entry.TsigKeyAlg != "" {
+				item["tsig_key_alg"] = entry.TsigKeyAlg
+			}
+		} else {
+			item["address"] = entry.Address
+			if entry.Permission != "" {
+				item["permission"] = entry.Permission
+			}
+		}
+		res = append(res, item)
+	}
+
+	return res, nil
+}
+
+// aclList is a list of access control entries which are either
+// structs or references to named ACLs, as NIOS returns them.
+type aclList []interface{}
+
+// UnmarshalJSON accepts a single entry as well as a list of entries.
+func (l *aclList) UnmarshalJSON(b []byte) error {
+	var entries []interface{}
+	if err := json.Unmarshal(b, &entries); err == nil {
+		*l = entries
+		return nil
+	}
+
+	var entry interface{}
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return err
+	}
+	if entry == nil {
+		*l = nil
+	} else {
+		*l = aclList{entry}
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package infoblox
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	eaNameForAllocationLock     = "Terraform Allocation Lock"
+	eaNameForAllocationLockTime = "Terraform Allocation Lock Time"
+
+	// allocationLockFreeValue is the value of the lock's EA of a network view which is not locked,
+	// the same as the go-client's NetworkViewLock uses.
+	allocationLockFreeValue = "Available"
+
+	// allocationLockMaxRetryInterval is the maximum time to wait, in seconds,
+	// before the next attempt to take the lock held by another agent.
+	allocationLockMaxRetryInterval = 5
+
+	defaultAllocationLockTimeout    = 300 // in seconds
+	defaultAllocationLockStaleAfter = 120 // in seconds
+)
+
+// providerConnector is the provider's meta data: the go-client's connector
+// along with the settings of the provider which the resources need.
+type providerConnector struct {
+	ibclient.IBConnector
+
+	// allocationLock is nil unless 'allocation_lock' setting of the provider is enabled.
+	allocationLock *allocationLock
+}
+
+// allocationLock serializes next-available allocations of networks and IP addresses
+// among concurrent Terraform runs, using the go-client's EA-based lock on the network view.
+type allocationLock struct {
+	// owner is the value of the lock's EA while the lock is held by this provider instance.
+	owner      string
+	timeout    time.Duration
+	staleAfter time.Duration
+
+	// viewMutexes serialize the allocations of this provider instance in every network view,
+	// not to have them poll NIOS for the lock concurrently.
+	viewMutexes sync.Map
+}
+
+func newAllocationLock(timeout, staleAfter time.Duration) *allocationLock {
+	return &allocationLock{
+		owner:      "terraform-" + uuid.NewString(),
+		timeout:    timeout,
+		staleAfter: staleAfter,
+	}
+}
+
+// withAllocationLock calls the function which allocates the next available network or IP address
+// in the network view, holding the allocation lock on the network view if the lock is enabled.
+func withAllocationLock(m interface{}, networkView string, allocate func() error) error {
+	conn, ok := m.(*providerConnector)
+	if !ok || conn.allocationLock == nil {
+		return allocate()
+	}
+	if networkView == "" {
+		networkView = defaultNetView
+	}
+
+	l := conn.allocationLock
+	mu, _ := l.viewMutexes.LoadOrStore(networkView, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	defer mu.(*sync.Mutex).Unlock()
+
+	nvLock, err := l.acquire(conn.IBConnector, networkView)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// The allocation is done anyway, so the failure is not reported as an error:
+		// the lock is to be taken over by another agent once it gets stale.
+		if err := nvLock.UnLock(false); err != nil {
+			log.Warningf("failed to release the allocation lock on network view '%s': %s", networkView, err)
+		}
+	}()
+
+	return allocate()
+}
+
+// acquire takes the lock on the network view, waiting for the lock to be released by another agent
+// for at most the timeout; the lock which is held for longer than 'staleAfter' is taken over.
+func (l *allocationLock) acquire(conn ibclient.IBConnector, networkView string) (*ibclient.NetworkViewLock, error) {
+	objMgr, ok := ibclient.NewObjectManager(conn, "Terraform", l.owner).(*ibclient.ObjectManager)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type of the object manager")
+	}
+	nvLock := &ibclient.NetworkViewLock{
+		Name:          networkView,
+		ObjMgr:        objMgr,
+		LockEA:        eaNameForAllocationLock,
+		LockTimeoutEA: eaNameForAllocationLockTime,
+	}
+
+	nv, err := objMgr.GetNetworkView(networkView)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network view '%s' to lock it for allocation: %w", networkView, err)
+	}
+	if _, found := nv.Ea[eaNameForAllocationLock]; !found {
+		eas := nv.Ea
+		if eas == nil {
+			eas = make(ibclient.EA)
+		}
+		eas[eaNameForAllocationLock] = allocationLockFreeValue
+		comment := ""
+		if nv.Comment != nil {
+			comment = *nv.Comment
+		}
+		if _, err = objMgr.UpdateNetworkView(nv.Ref, "", comment, eas); err != nil {
+			return nil, fmt.Errorf("failed to set up the allocation lock on network view '%s': %w", networkView, err)
+		}
+	}
+
+	deadline := time.Now().Add(l.timeout)
+	for {
+		if l.tryAcquire(objMgr, networkView) {
+			return nvLock, nil
+		}
+
+		nv, err = objMgr.GetNetworkView(networkView)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get network view '%s' to check its allocation lock: %w", networkView, err)
+		}
+		holder, _ := nv.Ea[eaNameForAllocationLock].(string)
+		if lockTime, found := nv.Ea[eaNameForAllocationLockTime].(int); found && holder != allocationLockFreeValue {
+			if since := time.Unix(int64(lockTime), 0); time.Since(since) > l.staleAfter {
+				log.Warningf("taking over the stale allocation lock on network view '%s' held by '%s' since %s",
+					networkView, holder, since.Format(time.RFC3339))
+				if err = nvLock.UnLock(true); err != nil {
+					return nil, fmt.Errorf("failed to take over the allocation lock on network view '%s': %w", networkView, err)
+				}
+				continue
+			}
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, fmt.Errorf(
+				"timed out after %s waiting for the allocation lock on network view '%s' held by '%s'",
+				l.timeout, networkView, holder)
+		}
+		wait := time.Duration(rand.Intn(allocationLockMaxRetryInterval)+1) * time.Second
+		if wait > remaining {
+			wait = remaining
+		}
+		log.Debugf("the allocation lock on network view '%s' is held by '%s', retrying in %s", networkView, holder, wait)
+		time.Sleep(wait)
+	}
+}
+
+// tryAcquire makes a single attempt to take the lock, the same way the go-client's NetworkViewLock does:
+// the lock's EA of the network view is set to the owner if only it is free, in a single WAPI request.
+func (l *allocationLock) tryAcquire(objMgr *ibclient.ObjectManager, networkView string) bool {
+	req := ibclient.NewMultiRequest([]*ibclient.RequestBody{
+		{
+			Method: "GET",
+			Object: "networkview",
+			Data: map[string]interface{}{
+				"name":                        networkView,
+				"*" + eaNameForAllocationLock: allocationLockFreeValue,
+			},
+			Args: map[string]string{
+				"_return_fields": "extattrs",
+			},
+			AssignState: map[string]string{
+				"NET_VIEW_REF": "_ref",
+			},
+			Discard: true,
+		},
+		{
+			Method: "PUT",
+			Object: "##STATE:NET_VIEW_REF:##",
+			Data: map[string]interface{}{
+				"extattrs+": map[string]interface{}{
+					eaNameForAllocationLock: map[string]string{
+						"value": l.owner,
+					},
+					eaNameForAllocationLockTime: map[string]int64{
+						"value": time.Now().Unix(),
+					},
+				},
+			},
+			EnableSubstitution: true,
+			Discard:            true,
+		},
+		{
+			Method: "GET",
+			Object: "##STATE:NET_VIEW_REF:##",
+			Args: map[string]string{
+				"_return_fields": "extattrs",
+			},
+			AssignState: map[string]string{
+				"LOCK_OWNER": "*" + eaNameForAllocationLock,
+			},
+			EnableSubstitution: true,
+			Discard:            true,
+		},
+		{
+			Method: "STATE:DISPLAY",
+		},
+	})
+
+	// The request fails if the lock is not free, as the network view is not found then.
+	res, err := objMgr.CreateMultiObject(req)
+	if err != nil || len(res) == 0 {
+		return false
+	}
+
+	return res[0]["LOCK_OWNER"] == l.owner
+}
@@ -0,0 +1,220 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceDNSRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the zone to search the records in.",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "The name of the DNS view in which the zone resides.",
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name_regex"},
+				Description: "The name of the records, relative to the zone, '@' for the zone's apex;" +
+					" an FQDN within the zone is accepted as well. All the names match if neither this nor" +
+					" 'name_regex' is set.",
+			},
+			"name_regex": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+				Description:   "The regular expression which the names of the records, relative to the zone, must match.",
+			},
+			"types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The types of the records to return, like 'A' or 'CNAME'; all the types if empty.",
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The records which match the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the record, like 'A' or 'MX'; host records' addresses are A and AAAA records.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the record relative to the zone, '@' for the zone's apex.",
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The fully qualified name of the record.",
+						},
+						"rdata": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: "The data of the record in the master file format;" +
+								" empty for the types which the provider cannot represent.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The TTL of the record; -1 if the record inherits the TTL of the zone.",
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether the record is disabled.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The comment of the record.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the record, as a map in JSON format.",
+						},
+						"ref": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reference of the record's object at NIOS side.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dnsRecordsNameFilter returns the search fields of 'allrecords' WAPI object, which select the records by name.
+func dnsRecordsNameFilter(zone string, name string, nameRegex string) map[string]string {
+	switch {
+	case nameRegex != "":
+		return map[string]string{"name~": nameRegex}
+	case name == "":
+		return nil
+	case name == zoneFileApexName || normalizeDnsName(name) == normalizeDnsName(zone):
+		return map[string]string{"name": ""}
+	}
+
+	name = dnsNameForWapi(name)
+	if suffix := "." + normalizeDnsName(zone); strings.HasSuffix(strings.ToLower(name), suffix) {
+		name = name[:len(name)-len(suffix)]
+	}
+
+	return map[string]string{"name": name}
+}
+
+func dataSourceDNSRecordsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	zone := dnsNameForWapi(d.Get("zone").(string))
+	view := d.Get("view").(string)
+	sf := dnsRecordsNameFilter(zone, d.Get("name").(string), d.Get("name_regex").(string))
+
+	types := make(map[string]bool)
+	for _, t := range d.Get("types").([]interface{}) {
+		if s, ok := t.(string); ok {
+			types[strings.ToUpper(s)] = true
+		}
+	}
+
+	allRecords, err := getZoneRecords(connector, zone, view, sf)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get the records of zone '%s': %w", zone, err))
+	}
+
+	matched := make([]allRecord, 0, len(allRecords))
+	for _, rec := range allRecords {
+		if len(types) == 0 || types[zoneRecordTypeName(rec.Type)] {
+			matched = append(matched, rec)
+		}
+	}
+
+	recData, err := getAllRecordsData(connector, zone, view, matched, true)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get the data of the records of zone '%s': %w", zone, err))
+	}
+
+	records := make([]interface{}, 0, len(matched))
+	for _, rec := range matched {
+		rrType := zoneRecordTypeName(rec.Type)
+		name, fqdn := rec.Name, zone
+		if name == "" {
+			name = zoneFileApexName
+		} else {
+			fqdn = name + "." + zone
+		}
+
+		ttl := ttlUndef
+		if rec.Ttl != nil {
+			ttl = int(*rec.Ttl)
+		}
+
+		rdata := ""
+		ea := ibclient.EA{}
+		if data, found := recData[rec.Record]; found {
+			rdata = data.rdata
+			if data.ea != nil {
+				ea = data.ea
+			}
+		} else if rec.Address != "" {
+			rdata = rec.Address
+		}
+		eaJSON, err := terraformSerializeEAs(ea)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		records = append(records, map[string]interface{}{
+			"type":      rrType,
+			"name":      name,
+			"fqdn":      fqdn,
+			"rdata":     rdata,
+			"ttl":       ttl,
+			"disabled":  rec.Disable,
+			"comment":   rec.Comment,
+			"ext_attrs": eaJSON,
+			"ref":       rec.Record,
+		})
+	}
+
+	// The records are ordered to make the result stable.
+	sort.SliceStable(records, func(i, j int) bool {
+		a, b := records[i].(map[string]interface{}), records[j].(map[string]interface{})
+		if a["name"] != b["name"] {
+			return a["name"].(string) < b["name"].(string)
+		}
+		if a["type"] != b["type"] {
+			return a["type"].(string) < b["type"].(string)
+		}
+		return a["rdata"].(string) < b["rdata"].(string)
+	})
+
+	if err = d.Set("records", records); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", view, zone))
+
+	return nil
+}
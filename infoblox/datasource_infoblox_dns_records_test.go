@@ -0,0 +1,67 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDNSRecords(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "dnsrecords-test.com"
+					}
+
+					resource "infoblox_a_record" "app01" {
+						fqdn = "app01.${infoblox_zone_auth.zone.fqdn}"
+						ip_addr = "10.0.0.11"
+						ttl = 300
+						ext_attrs = jsonencode({
+							"Location" = "test location"
+						})
+					}
+
+					resource "infoblox_txt_record" "app01" {
+						fqdn = "app01.${infoblox_zone_auth.zone.fqdn}"
+						text = "owner=team1"
+					}
+
+					resource "infoblox_cname_record" "app02" {
+						alias = "app02.${infoblox_zone_auth.zone.fqdn}"
+						canonical = "app01.dnsrecords-test.com"
+					}
+
+					data "infoblox_dns_records" "app01" {
+						zone = infoblox_zone_auth.zone.fqdn
+						name = "app01"
+						depends_on = [infoblox_a_record.app01, infoblox_txt_record.app01, infoblox_cname_record.app02]
+					}
+
+					data "infoblox_dns_records" "cnames" {
+						zone = infoblox_zone_auth.zone.fqdn
+						name_regex = "^app"
+						types = ["cname"]
+						depends_on = [infoblox_a_record.app01, infoblox_txt_record.app01, infoblox_cname_record.app02]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_dns_records.app01", "records.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_records.app01", "records.0.type", "A"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_records.app01", "records.0.fqdn", "app01.dnsrecords-test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_records.app01", "records.0.rdata", "10.0.0.11"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_records.app01", "records.0.ttl", "300"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_records.app01", "records.0.ext_attrs", `{"Location":"test location"}`),
+					resource.TestCheckResourceAttr("data.infoblox_dns_records.app01", "records.1.type", "TXT"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_records.app01", "records.1.ttl", "-1"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_records.cnames", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_records.cnames", "records.0.name", "app02"),
+					resource.TestCheckResourceAttr("data.infoblox_dns_records.cnames", "records.0.rdata", "app01.dnsrecords-test.com."),
+				),
+			},
+		},
+	})
+}
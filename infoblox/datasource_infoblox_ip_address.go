@@ -0,0 +1,277 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// ipAddressReturnFields are the fields of 'ipv4address' and 'ipv6address' WAPI objects the data sources read;
+// the MAC address of IPv4 addresses and the DUID of IPv6 ones are added to them.
+var ipAddressReturnFields = []string{
+	"ip_address", "network", "network_view", "status", "types", "names", "usage", "objects",
+	"lease_state", "is_conflict", "conflict_types", "comment", "username", "discovered_data", "extattrs",
+}
+
+func dataSourceIPv4Address() *schema.Resource {
+	return dataSourceIPAddress(false)
+}
+
+func dataSourceIPv6Address() *schema.Resource {
+	return dataSourceIPAddress(true)
+}
+
+// dataSourceIPAddress returns the data source which reports the IPAM data of the IP addresses of a network.
+func dataSourceIPAddress(isIPv6 bool) *schema.Resource {
+	addressSchema := map[string]*schema.Schema{
+		"ip_address": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The IP address.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the IP address: USED or UNUSED.",
+		},
+		"types": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The types of the objects associated with the IP address, like 'HOST', 'A' or 'LEASE'.",
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The DNS names associated with the IP address.",
+		},
+		"usage": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Whether the IP address is configured for DNS or DHCP.",
+		},
+		"objects": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The references of the objects associated with the IP address.",
+		},
+		"lease_state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The state of the DHCP lease of the IP address, if any.",
+		},
+		"is_conflict": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Determines whether the IP address is in conflict.",
+		},
+		"conflict_types": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The types of the conflicts of the IP address.",
+		},
+		"discovered": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Determines whether the IP address has been found in use by network discovery.",
+		},
+		"discovered_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the device with the IP address, as found by network discovery.",
+		},
+		"last_discovered": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The time the IP address was last found in use by network discovery, in RFC 3339 format.",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The comment of the IP address.",
+		},
+		"username": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the user who created or modified the IP address.",
+		},
+		"ext_attrs": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Extensible attributes of the IP address, as a map in JSON format.",
+		},
+	}
+	if isIPv6 {
+		addressSchema["duid"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The DUID of the IP address.",
+		}
+	} else {
+		addressSchema["mac_address"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The MAC address of the IP address.",
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return dataSourceIPAddressRead(d, m, isIPv6)
+		},
+		Schema: map[string]*schema.Schema{
+			"network": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The network, in CIDR format, the IP addresses of which are returned.",
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultNetView,
+				Description: "The network view in which the network resides.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"USED", "UNUSED"}, false),
+				Description:  "The status of the IP addresses to return, USED or UNUSED; all the IP addresses if empty.",
+			},
+			"types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The types of the associated objects, like 'HOST' or 'LEASE', the IP addresses to return " +
+					"have any of; all the IP addresses if empty.",
+			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IP addresses of the network which match the filters, in the order of the addresses.",
+				Elem:        &schema.Resource{Schema: addressSchema},
+			},
+		},
+	}
+}
+
+// ipAddressData holds the fields of 'ipv4address' and 'ipv6address' WAPI objects.
+type ipAddressData struct {
+	ibclient.IPv4Address
+	Duid string `json:"duid,omitempty"`
+}
+
+func dataSourceIPAddressRead(d *schema.ResourceData, m interface{}, isIPv6 bool) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	network := d.Get("network").(string)
+	networkView := d.Get("network_view").(string)
+	sf := map[string]string{
+		"network":      network,
+		"network_view": networkView,
+	}
+	if status := d.Get("status").(string); status != "" {
+		sf["status"] = status
+	}
+
+	types := make(map[string]bool)
+	for _, t := range d.Get("types").([]interface{}) {
+		if s, ok := t.(string); ok {
+			types[strings.ToUpper(s)] = true
+		}
+	}
+
+	objType, returnFields := "ipv4address", append([]string{"mac_address"}, ipAddressReturnFields...)
+	if isIPv6 {
+		objType, returnFields = "ipv6address", append([]string{"duid"}, ipAddressReturnFields...)
+	}
+
+	addresses := make([]interface{}, 0)
+	err := getObjectsPaged(connector, newWapiObject(objType, returnFields), sf, func(objects json.RawMessage) error {
+		var page []ipAddressData
+		if err := json.Unmarshal(objects, &page); err != nil {
+			return err
+		}
+		for _, addr := range page {
+			if len(types) > 0 && !ipAddressHasType(addr.Types, types) {
+				continue
+			}
+			data, err := flattenIPAddress(addr, isIPv6)
+			if err != nil {
+				return err
+			}
+			addresses = append(addresses, data)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get the IP addresses of network '%s': %w", network, err))
+	}
+
+	if err = d.Set("addresses", addresses); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", networkView, network))
+
+	return nil
+}
+
+// ipAddressHasType tells whether any of the types of the IP address is among the given ones.
+func ipAddressHasType(addrTypes []string, types map[string]bool) bool {
+	for _, t := range addrTypes {
+		if types[strings.ToUpper(t)] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flattenIPAddress(addr ipAddressData, isIPv6 bool) (map[string]interface{}, error) {
+	ea := addr.Ea
+	if ea == nil {
+		ea = ibclient.EA{}
+	}
+	eaJSON, err := terraformSerializeEAs(ea)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]interface{}{
+		"ip_address":     addr.IpAddress,
+		"status":         addr.Status,
+		"types":          addr.Types,
+		"names":          addr.Names,
+		"usage":          addr.Usage,
+		"objects":        addr.Objects,
+		"lease_state":    addr.LeaseState,
+		"is_conflict":    addr.IsConflict,
+		"conflict_types": addr.ConflictTypes,
+		"comment":        addr.Comment,
+		"username":       addr.Username,
+		"ext_attrs":      eaJSON,
+	}
+	if dd := addr.DiscoveredData; dd != nil && dd.LastDiscovered != nil && dd.LastDiscovered.Unix() > 0 {
+		res["discovered"] = true
+		res["discovered_name"] = dd.DiscoveredName
+		res["last_discovered"] = dd.LastDiscovered.UTC().Format(time.RFC3339)
+	}
+	if isIPv6 {
+		res["duid"] = addr.Duid
+	} else {
+		res["mac_address"] = addr.MacAddress
+	}
+
+	return res, nil
+}
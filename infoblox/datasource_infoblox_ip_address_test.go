@@ -0,0 +1,54 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIPv4Address(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "ipaddress-test.com"
+					}
+
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.42.0.0/24"
+					}
+
+					resource "infoblox_a_record" "app01" {
+						fqdn = "app01.${infoblox_zone_auth.zone.fqdn}"
+						ip_addr = "10.42.0.5"
+						depends_on = [infoblox_ipv4_network.net]
+					}
+
+					data "infoblox_ipv4_address" "used" {
+						network = infoblox_ipv4_network.net.cidr
+						status = "USED"
+						types = ["a"]
+						depends_on = [infoblox_a_record.app01]
+					}
+
+					data "infoblox_ipv4_address" "unused" {
+						network = infoblox_ipv4_network.net.cidr
+						status = "UNUSED"
+						depends_on = [infoblox_a_record.app01]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.used", "addresses.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.used", "addresses.0.ip_address", "10.42.0.5"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.used", "addresses.0.status", "USED"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.used", "addresses.0.names.0", "app01.ipaddress-test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.used", "addresses.0.discovered", "false"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.unused", "addresses.0.ip_address", "10.42.0.1"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_address.unused", "addresses.0.status", "UNUSED"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,189 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// nextAvailableSchema returns the fields shared by the data sources which preview the next available
+// networks and IP addresses; 'parentField' is the field with the CIDR of the object they are taken from.
+func nextAvailableSchema(parentField string, parentDesc string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"network_view": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     defaultNetView,
+			Description: "The network view in which the candidates are looked for.",
+		},
+		parentField: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{parentField, "filter_params"},
+			Description:  parentDesc,
+		},
+		"filter_params": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "The extensible attributes, as a map in JSON format, of the object the candidates are taken from, " +
+				"the same way as 'filter_params' field of the resources which allocate them.",
+		},
+		"ip_address_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "IPV4",
+			ValidateFunc: validation.StringInSlice([]string{"IPV4", "IPV6"}, false),
+			Description: "The IP version of the object selected by 'filter_params', IPV4 or IPV6; " +
+				"the IP version of the CIDR is used otherwise.",
+		},
+		"num": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The number of the candidates to return.",
+		},
+		"exclude": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The candidates which must not be returned.",
+		},
+	}
+}
+
+func dataSourceNextAvailableNetwork() *schema.Resource {
+	s := nextAvailableSchema("parent_cidr",
+		"The network container (or the network, see 'object' field), in CIDR format, the networks are taken from.")
+	s["object"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "networkcontainer",
+		ValidateFunc: validation.StringInSlice([]string{"networkcontainer", "network"}, false),
+		Description:  "The type of the object the networks are taken from: 'networkcontainer' or 'network'.",
+	}
+	s["allocate_prefix_len"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Required:    true,
+		Description: "The prefix length of the networks to return.",
+	}
+	s["networks"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The next available networks, in CIDR format.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceNextAvailableNetworkRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceNextAvailableIP() *schema.Resource {
+	s := nextAvailableSchema("cidr", "The network, in CIDR format, the IP addresses are taken from.")
+	s["ip_addresses"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The next available IP addresses.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceNextAvailableIPRead,
+		Schema:      s,
+	}
+}
+
+// getNextAvailableCandidates calls the next available function 'function' of the object of type 'objType',
+// or of its IPv6 counterpart, which is selected by the CIDR in 'parentField' or by 'filter_params' field,
+// without allocating anything. The ID of the data source is set.
+func getNextAvailableCandidates(
+	d *schema.ResourceData, m interface{}, parentField string, objType string, function string, args map[string]interface{}, res interface{}) error {
+
+	connector := m.(ibclient.IBConnector)
+	networkView := d.Get("network_view").(string)
+	parentCidr := d.Get(parentField).(string)
+	filter := d.Get("filter_params").(string)
+
+	var (
+		isIPv6 bool
+		eaMap  map[string]string
+		err    error
+	)
+	if parentCidr != "" {
+		if isIPv6, err = isIPv6Cidr(parentCidr); err != nil {
+			return fmt.Errorf("'%s' is not a valid CIDR: %w", parentCidr, err)
+		}
+	} else {
+		isIPv6 = d.Get("ip_address_type").(string) == "IPV6"
+		if eaMap, err = parseNextAvailableFilter(filter, networkView); err != nil {
+			return fmt.Errorf("error unmarshalling 'filter_params': %w", err)
+		}
+	}
+	if isIPv6 {
+		objType = "ipv6" + objType
+	}
+
+	ref, err := getNextAvailableParent(connector, objType, networkView, parentCidr, eaMap)
+	if err != nil {
+		return err
+	}
+	if err = callWapiFunction(connector, ref, function, args, res); err != nil {
+		return err
+	}
+
+	parent := parentCidr
+	if parent == "" {
+		parent = filter
+	}
+	d.SetId(fmt.Sprintf("%s/%s", networkView, parent))
+
+	return nil
+}
+
+func dataSourceNextAvailableNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	args := nextAvailableArgs(d.Get("num").(int), d.Get("exclude").([]interface{}))
+	args["cidr"] = d.Get("allocate_prefix_len").(int)
+
+	var res struct {
+		Networks []string `json:"networks"`
+	}
+	objType := nextAvailableNetworkObjType(false, d.Get("object").(string))
+	if err := getNextAvailableCandidates(d, m, "parent_cidr", objType, "next_available_network", args, &res); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get the next available networks: %w", err))
+	}
+
+	if res.Networks == nil {
+		res.Networks = []string{}
+	}
+	if err := d.Set("networks", res.Networks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dataSourceNextAvailableIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	args := nextAvailableArgs(d.Get("num").(int), d.Get("exclude").([]interface{}))
+
+	var res struct {
+		IPs []string `json:"ips"`
+	}
+	if err := getNextAvailableCandidates(d, m, "cidr", "network", "next_available_ip", args, &res); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get the next available IP addresses: %w", err))
+	}
+
+	if res.IPs == nil {
+		res.IPs = []string{}
+	}
+	if err := d.Set("ip_addresses", res.IPs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
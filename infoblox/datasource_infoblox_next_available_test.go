@@ -0,0 +1,80 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNextAvailableNetwork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network_container" "parent" {
+						cidr = "10.43.0.0/16"
+						ext_attrs = jsonencode({
+							"Site" = "Next Available Test"
+						})
+					}
+
+					resource "infoblox_ipv4_network" "used" {
+						cidr = "10.43.0.0/24"
+						depends_on = [infoblox_ipv4_network_container.parent]
+					}
+
+					data "infoblox_next_available_network" "by_cidr" {
+						parent_cidr = infoblox_ipv4_network_container.parent.cidr
+						allocate_prefix_len = 24
+						num = 2
+						exclude = ["10.43.1.0/24"]
+						depends_on = [infoblox_ipv4_network.used]
+					}
+
+					data "infoblox_next_available_network" "by_ea" {
+						filter_params = jsonencode({
+							"*Site" = "Next Available Test"
+						})
+						allocate_prefix_len = 25
+						depends_on = [infoblox_ipv4_network.used]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_next_available_network.by_cidr", "networks.#", "2"),
+					resource.TestCheckResourceAttr("data.infoblox_next_available_network.by_cidr", "networks.0", "10.43.2.0/24"),
+					resource.TestCheckResourceAttr("data.infoblox_next_available_network.by_cidr", "networks.1", "10.43.3.0/24"),
+					resource.TestCheckResourceAttr("data.infoblox_next_available_network.by_ea", "networks.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_next_available_network.by_ea", "networks.0", "10.43.1.0/25"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceNextAvailableIP(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.44.0.0/24"
+					}
+
+					data "infoblox_next_available_ip" "ips" {
+						cidr = infoblox_ipv4_network.net.cidr
+						num = 3
+						exclude = ["10.44.0.2"]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_next_available_ip.ips", "ip_addresses.#", "3"),
+					resource.TestCheckResourceAttr("data.infoblox_next_available_ip.ips", "ip_addresses.0", "10.44.0.1"),
+					resource.TestCheckResourceAttr("data.infoblox_next_available_ip.ips", "ip_addresses.1", "10.44.0.3"),
+					resource.TestCheckResourceAttr("data.infoblox_next_available_ip.ips", "ip_addresses.2", "10.44.0.4"),
+				),
+			},
+		},
+	})
+}
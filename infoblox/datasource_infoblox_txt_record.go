@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -45,7 +46,13 @@ func dataSourceTXTRecord() *schema.Resource {
 						"text": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "Data of the TXT-Record.",
+							Description: "Data of the TXT-Record, the concatenation of its character strings.",
+						},
+						"text_strings": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Character strings of the TXT-Record.",
 						},
 						"zone": {
 							Type:        schema.TypeString,
@@ -137,7 +144,9 @@ func flattenRecordTXT(recordtxt ibclient.RecordTXT) (map[string]interface{}, err
 	}
 
 	if recordtxt.Text != nil {
-		res["text"] = *recordtxt.Text
+		strs := parseTxtData(*recordtxt.Text)
+		res["text"] = strings.Join(strs, "")
+		res["text_strings"] = strs
 	}
 
 	if recordtxt.UseTtl != nil {
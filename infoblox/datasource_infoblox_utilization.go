@@ -0,0 +1,268 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// dhcpStatisticsSchema returns the fields with the DHCP statistics of a network or a DHCP range.
+func dhcpStatisticsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"dhcp_utilization": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The DHCP utilization, the percentage of the DHCP addresses in use, with one decimal.",
+		},
+		"dhcp_utilization_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The DHCP utilization level: FULL, HIGH, NORMAL or LOW.",
+		},
+		"static_hosts": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of the static DHCP addresses (fixed addresses and reservations).",
+		},
+		"dynamic_hosts": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of the DHCP leases issued.",
+		},
+		"total_hosts": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The total number of the DHCP addresses.",
+		},
+	}
+}
+
+func dataSourceIPv4NetworkUtilization() *schema.Resource {
+	s := dhcpStatisticsSchema()
+	s["cidr"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The network or the network container, in CIDR format, the utilization of which is returned.",
+	}
+	s["network_view"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     defaultNetView,
+		Description: "The network view in which the network or the network container resides.",
+	}
+	s["object_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of the object with the CIDR: 'network' or 'networkcontainer'.",
+	}
+	s["utilization"] = &schema.Schema{
+		Type:        schema.TypeFloat,
+		Computed:    true,
+		Description: "The IPAM utilization, the percentage of the IP addresses in use, with one decimal.",
+	}
+	s["utilization_update"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the IPAM utilization was last updated, in RFC 3339 format; networks only.",
+	}
+	s["conflict_count"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of the conflicts found by network discovery; networks only.",
+	}
+	s["unmanaged_count"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of the unmanaged IP addresses found by network discovery; networks only.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIPv4NetworkUtilizationRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceIPv4RangeUtilization() *schema.Resource {
+	s := dhcpStatisticsSchema()
+	s["start_addr"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The start address of the DHCP range.",
+	}
+	s["end_addr"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The end address of the DHCP range.",
+	}
+	s["network_view"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     defaultNetView,
+		Description: "The network view in which the DHCP range resides.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIPv4RangeUtilizationRead,
+		Schema:      s,
+	}
+}
+
+// utilizationPercent converts the utilization WAPI reports, in tenths of a percent, to a percentage.
+func utilizationPercent(utilization uint32) float64 {
+	return float64(utilization) / 10
+}
+
+// getObjectRef returns the reference of the first object of type 'objType' which matches the search fields,
+// an empty string if there is none.
+func getObjectRef(connector ibclient.IBConnector, objType string, sf map[string]string) (string, error) {
+	var objects []struct {
+		Ref string `json:"_ref"`
+	}
+	err := connector.GetObject(newWapiObject(objType, []string{"comment"}), "", ibclient.NewQueryParams(false, sf), &objects)
+	if err != nil && !isNotFoundError(err) {
+		return "", err
+	}
+	if len(objects) == 0 {
+		return "", nil
+	}
+
+	return objects[0].Ref, nil
+}
+
+// setDhcpStatistics reads the DHCP statistics of the object with the reference 'ref' into the data source.
+func setDhcpStatistics(connector ibclient.IBConnector, d *schema.ResourceData, ref string) error {
+	var stats []ibclient.DhcpStatistics
+	err := connector.GetObject(
+		newWapiObject("dhcp:statistics", ibclient.DhcpStatistics{}.ReturnFields()), "",
+		ibclient.NewQueryParams(false, map[string]string{"statistics_object": ref}), &stats)
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("failed to get the DHCP statistics: %w", err)
+	}
+
+	var st ibclient.DhcpStatistics
+	if len(stats) > 0 {
+		st = stats[0]
+	}
+	if err = d.Set("dhcp_utilization", utilizationPercent(st.DhcpUtilization)); err != nil {
+		return err
+	}
+	if err = d.Set("dhcp_utilization_status", st.DhcpUtilizationStatus); err != nil {
+		return err
+	}
+	if err = d.Set("static_hosts", int(st.StaticHosts)); err != nil {
+		return err
+	}
+	if err = d.Set("dynamic_hosts", int(st.DynamicHosts)); err != nil {
+		return err
+	}
+
+	return d.Set("total_hosts", int(st.TotalHosts))
+}
+
+func dataSourceIPv4NetworkUtilizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	cidr := d.Get("cidr").(string)
+	networkView := d.Get("network_view").(string)
+	if isIPv6, err := isIPv6Cidr(cidr); err != nil || isIPv6 {
+		return diag.Errorf("'%s' is not a valid IPv4 CIDR", cidr)
+	}
+	sf := map[string]string{
+		"network":      cidr,
+		"network_view": networkView,
+	}
+
+	var objType, ref string
+	for _, objType = range []string{"network", "networkcontainer"} {
+		var err error
+		if ref, err = getObjectRef(connector, objType, sf); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to search for network '%s': %w", cidr, err))
+		}
+		if ref != "" {
+			break
+		}
+	}
+	if ref == "" {
+		return diag.Errorf("there is no network or network container '%s' in network view '%s'", cidr, networkView)
+	}
+
+	var stats []ibclient.IpamStatistics
+	returnFields := []string{"utilization", "utilization_update", "conflict_count", "unmanaged_count"}
+	err := connector.GetObject(newWapiObject("ipam:statistics", returnFields), "", ibclient.NewQueryParams(false, sf), &stats)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(fmt.Errorf("failed to get the IPAM statistics of network '%s': %w", cidr, err))
+	}
+	var st ibclient.IpamStatistics
+	if len(stats) > 0 {
+		st = stats[0]
+	}
+
+	var updated string
+	if objType == "network" && st.UtilizationUpdate != nil && st.UtilizationUpdate.Unix() > 0 {
+		updated = st.UtilizationUpdate.UTC().Format(time.RFC3339)
+	}
+	if err = d.Set("object_type", objType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("utilization", utilizationPercent(st.Utilization)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("utilization_update", updated); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("conflict_count", int(st.ConflictCount)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("unmanaged_count", int(st.UnmanagedCount)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// DHCP statistics are kept for networks only, the DHCP fields of network containers are left empty.
+	if objType == "network" {
+		if err = setDhcpStatistics(connector, d, ref); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to get the utilization of network '%s': %w", cidr, err))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", networkView, cidr))
+
+	return nil
+}
+
+func dataSourceIPv4RangeUtilizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	startAddr := d.Get("start_addr").(string)
+	endAddr := d.Get("end_addr").(string)
+	networkView := d.Get("network_view").(string)
+	for _, addr := range []string{startAddr, endAddr} {
+		if ip := net.ParseIP(addr); ip == nil || ip.To4() == nil {
+			return diag.Errorf("'%s' is not a valid IPv4 address", addr)
+		}
+	}
+
+	ref, err := getObjectRef(connector, "range", map[string]string{
+		"start_addr":   startAddr,
+		"end_addr":     endAddr,
+		"network_view": networkView,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to search for DHCP range '%s-%s': %w", startAddr, endAddr, err))
+	}
+	if ref == "" {
+		return diag.Errorf("there is no DHCP range '%s-%s' in network view '%s'", startAddr, endAddr, networkView)
+	}
+
+	if err = setDhcpStatistics(connector, d, ref); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get the utilization of DHCP range '%s-%s': %w", startAddr, endAddr, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s-%s", networkView, startAddr, endAddr))
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package infoblox
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIPv4NetworkUtilization(t *testing.T) {
+	netConfig := `
+		resource "infoblox_ipv4_network_container" "parent" {
+			cidr = "10.45.0.0/16"
+		}
+
+		resource "infoblox_ipv4_network" "net" {
+			cidr = "10.45.0.0/24"
+			reserve_ip = 10
+			depends_on = [infoblox_ipv4_network_container.parent]
+		}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: netConfig,
+			},
+			{
+				PreConfig: func() {
+					testAccCreateRange("10.45.0.0/24", "10.45.0.100", "10.45.0.149", nil)
+				},
+				Config: netConfig + `
+					data "infoblox_ipv4_network_utilization" "net" {
+						cidr = infoblox_ipv4_network.net.cidr
+					}
+
+					data "infoblox_ipv4_network_utilization" "container" {
+						cidr = infoblox_ipv4_network_container.parent.cidr
+					}
+
+					data "infoblox_ipv4_range_utilization" "range" {
+						start_addr = "10.45.0.100"
+						end_addr = "10.45.0.149"
+						depends_on = [infoblox_ipv4_network.net]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_network_utilization.net", "object_type", "network"),
+					resource.TestCheckResourceAttrSet("data.infoblox_ipv4_network_utilization.net", "utilization"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_network_utilization.net", "conflict_count", "0"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_network_utilization.container", "object_type", "networkcontainer"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_network_utilization.container", "total_hosts", "0"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_range_utilization.range", "total_hosts", "50"),
+					resource.TestCheckResourceAttr("data.infoblox_ipv4_range_utilization.range", "dynamic_hosts", "0"),
+				),
+			},
+			{
+				Config: `
+					data "infoblox_ipv4_network_utilization" "missing" {
+						cidr = "10.46.0.0/24"
+					}`,
+				ExpectError: regexp.MustCompile("there is no network or network container '10.46.0.0/24'"),
+			},
+		},
+	})
+}
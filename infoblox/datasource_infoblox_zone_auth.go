@@ -63,6 +63,10 @@ func dataSourceZoneAuth() *schema.Resource {
 							Computed:    true,
 							Description: "Extensible attributes of the zone, as a map in JSON format",
 						},
+						"allow_query":       aclComputedSchema("The access control list of the clients which are allowed to query the zone."),
+						"allow_transfer":    aclComputedSchema("The access control list of the clients which are allowed to transfer the zone."),
+						"allow_update":      aclComputedSchema("The access control list of the clients which are allowed to send dynamic DNS updates for the zone."),
+						"update_forwarding": aclComputedSchema("The access control list of the clients whose dynamic DNS updates are forwarded to the primary server of the zone."),
 					},
 				},
 			},
@@ -76,11 +80,13 @@ func dataSourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m inter
 	var diags diag.Diagnostics
 
 	n := &ibclient.ZoneAuth{}
-	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "comment", "zone_format", "ns_group"))
+	n.SetReturnFields(append(n.ReturnFields(), "extattrs", "comment", "zone_format", "ns_group",
+		"allow_query", "use_allow_query", "allow_transfer", "use_allow_transfer",
+		"allow_update", "use_allow_update", "update_forwarding", "allow_update_forwarding"))
 
 	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
 	qp := ibclient.NewQueryParams(false, filters)
-	var res []ibclient.ZoneAuth
+	var res []zoneAuth
 
 	err := connector.GetObject(n, "", qp, &res)
 	if err != nil {
@@ -114,7 +120,7 @@ func dataSourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m inter
 	return diags
 }
 
-func flattenZoneAuth(zoneauth ibclient.ZoneAuth) (map[string]interface{}, error) {
+func flattenZoneAuth(zoneauth zoneAuth) (map[string]interface{}, error) {
 	var eaMap map[string]interface{}
 	if zoneauth.Ea != nil && len(zoneauth.Ea) > 0 {
 		eaMap = zoneauth.Ea
@@ -146,5 +152,13 @@ func flattenZoneAuth(zoneauth ibclient.ZoneAuth) (map[string]interface{}, error)
 		res["ns_group"] = *zoneauth.NsGroup
 	}
 
+	acls, err := flattenZoneAuthAcls(&zoneauth)
+	if err != nil {
+		return nil, err
+	}
+	for field, entries := range acls {
+		res[field] = entries
+	}
+
 	return res, nil
 }
@@ -18,6 +18,9 @@ func TestAccDataSourceZoneAuth(t *testing.T) {
 					resource.TestCheckResourceAttr("data.infoblox_zone_auth.acctest", "results.0.fqdn", "test2.com"),
 					resource.TestCheckResourceAttr("data.infoblox_zone_auth.acctest", "results.0.zone_format", "FORWARD"),
 					resource.TestCheckResourceAttr("data.infoblox_zone_auth.acctest", "results.0.comment", "test forward mapping zone"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_auth.acctest", "results.0.allow_transfer.0.address", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_auth.acctest", "results.0.allow_transfer.0.permission", "ALLOW"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_auth.acctest", "results.0.allow_update.#", "0"),
 				),
 			},
 			{
@@ -47,6 +50,9 @@ resource "infoblox_zone_auth" "foo"{
 	ext_attrs = jsonencode({
 		Location = "TestMapping"
 	})
+	allow_transfer {
+		address = "10.0.0.0/24"
+	}
 }
 
 data "infoblox_zone_auth" "acctest" {
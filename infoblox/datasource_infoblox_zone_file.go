@@ -0,0 +1,129 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceZoneFile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZoneFileRead,
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the authoritative zone to export.",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "The name of the DNS view in which the zone resides.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The records of the zone as an RFC 1035 master file.",
+			},
+			"unsupported_records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The records of the types which cannot be exported, in the form '<name> <type>'.",
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The records of the zone, the SOA record is the first one.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the record relative to the zone, '@' for the zone's apex.",
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The absolute name of the record.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the record, like 'A' or 'MX'.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The effective TTL of the record.",
+						},
+						"rdata": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The data of the record in the master file format.",
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether the record is disabled; disabled records are commented out in 'content'.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The comment of the record.",
+						},
+						"ref": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reference of the record's object at NIOS side, empty for the SOA record.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZoneFileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	fqdn := d.Get("fqdn").(string)
+	view := d.Get("view").(string)
+
+	zone, err := exportZoneFile(connector, fqdn, view)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to export zone '%s': %w", fqdn, err))
+	}
+
+	records := make([]interface{}, 0, len(zone.records))
+	for _, rec := range zone.records {
+		records = append(records, map[string]interface{}{
+			"name":     rec.name,
+			"fqdn":     rec.fqdn,
+			"type":     rec.rrType,
+			"ttl":      int(rec.ttl),
+			"rdata":    rec.rdata,
+			"disabled": rec.disabled,
+			"comment":  rec.comment,
+			"ref":      rec.ref,
+		})
+	}
+
+	if err = d.Set("content", zone.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("records", records); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("unsupported_records", zone.unsupported); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", view, zone.origin))
+
+	return nil
+}
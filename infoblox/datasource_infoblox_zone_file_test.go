@@ -0,0 +1,56 @@
+package infoblox
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceZoneFile(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "zonefile-test.com"
+						soa_default_ttl = 3600
+					}
+
+					resource "infoblox_a_record" "www" {
+						fqdn = "www.${infoblox_zone_auth.zone.fqdn}"
+						ip_addr = "10.0.0.10"
+						ttl = 300
+					}
+
+					resource "infoblox_mx_record" "mx" {
+						fqdn = infoblox_zone_auth.zone.fqdn
+						mail_exchanger = "mail.zonefile-test.com"
+						preference = 10
+					}
+
+					data "infoblox_zone_file" "export" {
+						fqdn = infoblox_zone_auth.zone.fqdn
+						depends_on = [infoblox_a_record.www, infoblox_mx_record.mx]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_zone_file.export", "records.0.type", "SOA"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_file.export", "records.0.ttl", "3600"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_file.export", "records.1.name", "@"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_file.export", "records.1.type", "MX"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_file.export", "records.1.rdata", "10 mail.zonefile-test.com."),
+					resource.TestCheckResourceAttr("data.infoblox_zone_file.export", "records.2.name", "www"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_file.export", "records.2.fqdn", "www.zonefile-test.com."),
+					resource.TestCheckResourceAttr("data.infoblox_zone_file.export", "records.2.ttl", "300"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_file.export", "records.2.rdata", "10.0.0.10"),
+					resource.TestMatchResourceAttr("data.infoblox_zone_file.export", "content",
+						regexp.MustCompile(`(?m)^\$ORIGIN zonefile-test\.com\.$`)),
+					resource.TestMatchResourceAttr("data.infoblox_zone_file.export", "content",
+						regexp.MustCompile(`(?m)^www\t300\tIN\tA\t10\.0\.0\.10$`)),
+				),
+			},
+		},
+	})
+}
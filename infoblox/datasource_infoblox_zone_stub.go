@@ -0,0 +1,256 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func dataSourceZoneStub() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZoneStubRead,
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of Stub Zones matching filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of this DNS zone.",
+						},
+						"view": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The DNS view in which the zone is created.",
+						},
+						"zone_format": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The format of the zone. Valid values are: FORWARD, IPV4, IPV6.",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The RFC2317 prefix value of this DNS zone.",
+						},
+						"stub_from": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The primary servers (masters) of the zone.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IP address of the primary server.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the primary server.",
+									},
+								},
+							},
+						},
+						"stub_members": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The Grid members which serve the stub zone.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the Grid member in FQDN format.",
+									},
+								},
+							},
+						},
+						"stub_msservers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The Microsoft DNS servers which serve the stub zone.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The address of the Microsoft server.",
+									},
+									"ns_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name which is used in the NS record of the zone for this server.",
+									},
+									"ns_ip": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IP address which is used in the glue record of the zone for this server.",
+									},
+									"stealth": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Determines if NS and glue records for the server are hidden.",
+									},
+									"is_master": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Determines if the server is a primary server of the zone.",
+									},
+								},
+							},
+						},
+						"ms_ad_integrated": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines whether Active Directory is integrated or not.",
+						},
+						"ns_group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A stub member name server group.",
+						},
+						"external_ns_group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A forward stub server name server group.",
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines if the zone is disabled or not.",
+						},
+						"disable_forwarding": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Determines if the name servers, which host the zone, must not forward queries for the zone's names.",
+						},
+						"locked": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "If set, other administrators cannot make conflicting changes.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A descriptive comment.",
+						},
+						"ext_attrs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Extensible attributes of the zone stub, as a map in JSON format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZoneStubRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	var diags diag.Diagnostics
+
+	filters := filterFromMap(d.Get("filters").(map[string]interface{}))
+
+	var res []ibclient.ZoneStub
+	qp := ibclient.NewQueryParams(false, filters)
+	err := connector.GetObject(newEmptyZoneStub(), "", qp, &res)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get zone stub records: %w", err))
+	}
+
+	if res == nil {
+		return diag.FromErr(fmt.Errorf("API returns a nil/empty ID for zone stub"))
+	}
+
+	results := make([]interface{}, 0, len(res))
+	for _, r := range res {
+		zsFlat, err := flattenZoneStub(r)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to flatten zone stub: %w", err))
+		}
+		results = append(results, zsFlat)
+	}
+
+	err = d.Set("results", results)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func flattenZoneStub(zs ibclient.ZoneStub) (map[string]interface{}, error) {
+	var eaMap map[string]interface{}
+	if zs.Ea != nil && len(zs.Ea) > 0 {
+		eaMap = zs.Ea
+	} else {
+		eaMap = make(map[string]interface{})
+	}
+
+	ea, err := json.Marshal(eaMap)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]interface{}{
+		"id":             zs.Ref,
+		"fqdn":           zs.Fqdn,
+		"ext_attrs":      string(ea),
+		"zone_format":    zs.ZoneFormat,
+		"stub_from":      convertNameServersToInterface(zs.StubFrom),
+		"stub_members":   convertStubMembersToInterface(zs.StubMembers),
+		"stub_msservers": convertMsDnsServersToInterface(zs.StubMsservers),
+	}
+	if zs.View != nil {
+		res["view"] = *zs.View
+	}
+	if zs.Prefix != nil {
+		res["prefix"] = *zs.Prefix
+	}
+	if zs.Comment != nil {
+		res["comment"] = *zs.Comment
+	}
+	if zs.NsGroup != nil {
+		res["ns_group"] = *zs.NsGroup
+	}
+	if zs.ExternalNsGroup != nil {
+		res["external_ns_group"] = *zs.ExternalNsGroup
+	}
+	if zs.Disable != nil {
+		res["disable"] = *zs.Disable
+	}
+	if zs.DisableForwarding != nil {
+		res["disable_forwarding"] = *zs.DisableForwarding
+	}
+	if zs.Locked != nil {
+		res["locked"] = *zs.Locked
+	}
+	if zs.MsAdIntegrated != nil {
+		res["ms_ad_integrated"] = *zs.MsAdIntegrated
+	}
+
+	return res, nil
+}
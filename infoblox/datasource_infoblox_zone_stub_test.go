@@ -0,0 +1,48 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceZoneStub(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneStubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_stub" "stub" {
+						fqdn = "stub-ds.test.com"
+						comment = "stub zone for data source"
+						stub_from {
+							name = "ns1.partner.com"
+							address = "10.20.0.1"
+						}
+						ext_attrs = jsonencode({
+							"Location" = "TBD"
+						})
+					}
+					data "infoblox_zone_stub" "stub_read" {
+						filters = {
+							fqdn = infoblox_zone_stub.stub.fqdn
+							"*Location" = "TBD"
+						}
+						depends_on = [infoblox_zone_stub.stub]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infoblox_zone_stub.stub_read", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_stub.stub_read", "results.0.fqdn", "stub-ds.test.com"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_stub.stub_read", "results.0.view", "default"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_stub.stub_read", "results.0.comment", "stub zone for data source"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_stub.stub_read", "results.0.stub_from.0.name", "ns1.partner.com"),
+					resource.TestCheckResourceAttr("data.infoblox_zone_stub.stub_read", "results.0.stub_from.0.address", "10.20.0.1"),
+					resource.TestCheckResourceAttrPair("data.infoblox_zone_stub.stub_read", "results.0.ext_attrs",
+						"infoblox_zone_stub.stub", "ext_attrs"),
+				),
+			},
+		},
+	})
+}
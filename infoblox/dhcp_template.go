@@ -0,0 +1,478 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// dhcpTemplate describes a resource of a NIOS template (network, range and fixed address templates),
+// which are all managed the same way, apart from their specific fields.
+type dhcpTemplate struct {
+	// objType is the WAPI object type of the template.
+	objType string
+
+	// title is the name of the template type in error messages, like 'network template'.
+	title string
+
+	// fields are the schemas of the template's specific fields.
+	fields map[string]*schema.Schema
+
+	// returnFields are the WAPI fields of the template's specific fields.
+	returnFields []string
+
+	// formData returns the WAPI fields of the template's specific fields.
+	formData func(d *schema.ResourceData) (map[string]interface{}, error)
+
+	// setFields sets the template's specific fields of the resource from the WAPI object.
+	setFields func(d *schema.ResourceData, rec map[string]interface{}) error
+}
+
+// dhcpTemplateObject is a template WAPI object, with its fields as a JSON map.
+type dhcpTemplateObject struct {
+	ibclient.IBBase `json:"-"`
+
+	objType string
+	data    map[string]interface{}
+}
+
+func (o *dhcpTemplateObject) ObjectType() string {
+	return o.objType
+}
+
+func (o *dhcpTemplateObject) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.data)
+}
+
+// dhcpSpecialOptions are the DHCP options which have their own flag telling whether they are inherited.
+var dhcpSpecialOptions = map[string]bool{
+	"routers":                  true,
+	"router-templates":         true,
+	"domain-name-servers":      true,
+	"domain-name":              true,
+	"broadcast-address":        true,
+	"broadcast-address-offset": true,
+	"dhcp-lease-time":          true,
+	"dhcp6.name-servers":       true,
+}
+
+// dhcpTemplateOptionsSchema is the field with the DHCP options of a template.
+var dhcpTemplateOptionsSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Optional:    true,
+	Description: "The DHCP options of the template; the options are inherited if none is set.",
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the DHCP option, like 'domain-name-servers'.",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The value of the DHCP option; lists are separated by commas.",
+			},
+		},
+	},
+}
+
+func convertDhcpTemplateOptionsFromInterface(options []interface{}) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(options))
+	for _, o := range options {
+		opt, ok := o.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := opt["name"].(string)
+		option := map[string]interface{}{
+			"name":  name,
+			"value": opt["value"].(string),
+		}
+		if dhcpSpecialOptions[name] {
+			option["use_option"] = true
+		}
+		res = append(res, option)
+	}
+
+	return res
+}
+
+func convertDhcpTemplateOptionsToInterface(options interface{}) []interface{} {
+	var opts []*ibclient.Dhcpoption
+	if err := convertWapiObject(options, &opts); err != nil {
+		return nil
+	}
+
+	res := make([]interface{}, 0, len(opts))
+	for _, opt := range opts {
+		// The inherited options NIOS reports along with the template's own ones are skipped.
+		if opt == nil || (dhcpSpecialOptions[opt.Name] && !opt.UseOption) {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"name":  opt.Name,
+			"value": opt.Value,
+		})
+	}
+
+	return res
+}
+
+// convertDhcpMembersFromInterface converts the names of Grid members into 'dhcpmember' WAPI structures.
+func convertDhcpMembersFromInterface(names []interface{}) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(names))
+	for _, name := range convertStringList(names) {
+		res = append(res, map[string]interface{}{"_struct": "dhcpmember", "name": name})
+	}
+
+	return res
+}
+
+func convertDhcpMembersToInterface(members interface{}) []string {
+	res := make([]string, 0)
+	list, _ := members.([]interface{})
+	for _, m := range list {
+		member, ok := m.(map[string]interface{})
+		if !ok || member["_struct"] != "dhcpmember" {
+			continue
+		}
+		if name, ok := member["name"].(string); ok {
+			res = append(res, name)
+		}
+	}
+
+	return res
+}
+
+// wapiInt returns the integer value of a field of a WAPI object represented as a JSON map.
+func wapiInt(rec map[string]interface{}, field string) int {
+	v, _ := rec[field].(float64)
+	return int(v)
+}
+
+func wapiString(rec map[string]interface{}, field string) string {
+	v, _ := rec[field].(string)
+	return v
+}
+
+func wapiBool(rec map[string]interface{}, field string) bool {
+	v, _ := rec[field].(bool)
+	return v
+}
+
+func (t *dhcpTemplate) resource() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: fmt.Sprintf("The name of the %s.", t.title),
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "A descriptive comment.",
+		},
+		"options": dhcpTemplateOptionsSchema,
+		"ext_attrs": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: fmt.Sprintf("Extensible attributes of the %s, as a map in JSON format.", t.title),
+		},
+		"internal_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "Internal ID of an object at NIOS side," +
+				" used by Infoblox Terraform plugin to search for a NIOS's object" +
+				" which corresponds to the Terraform resource.",
+		},
+		"ref": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "NIOS object's reference, not to be set by a user.",
+		},
+	}
+	for name, field := range t.fields {
+		s[name] = field
+	}
+
+	return &schema.Resource{
+		Create: t.create,
+		Read:   t.read,
+		Update: t.update,
+		Delete: t.delete,
+		Importer: &schema.ResourceImporter{
+			State: t.importState,
+		},
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Schema: s,
+	}
+}
+
+func (t *dhcpTemplate) newEmptyObject() *wapiObject {
+	return newWapiObject(t.objType, append([]string{"name", "comment", "options", "extattrs"}, t.returnFields...))
+}
+
+// form builds a WAPI object out of the resource's data.
+func (t *dhcpTemplate) form(d *schema.ResourceData) (*dhcpTemplateObject, error) {
+	data, err := t.formData(d)
+	if err != nil {
+		return nil, err
+	}
+
+	options := convertDhcpTemplateOptionsFromInterface(d.Get("options").([]interface{}))
+	data["name"] = d.Get("name").(string)
+	data["comment"] = d.Get("comment").(string)
+	data["options"] = options
+	data["use_options"] = len(options) > 0
+
+	return &dhcpTemplateObject{objType: t.objType, data: data}, nil
+}
+
+func (t *dhcpTemplate) setResourceFields(d *schema.ResourceData, rec map[string]interface{}) error {
+	if err := d.Set("name", wapiString(rec, "name")); err != nil {
+		return err
+	}
+	if err := d.Set("comment", wapiString(rec, "comment")); err != nil {
+		return err
+	}
+	if err := d.Set("options", convertDhcpTemplateOptionsToInterface(rec["options"])); err != nil {
+		return err
+	}
+	if err := t.setFields(d, rec); err != nil {
+		return err
+	}
+
+	return d.Set("ref", wapiString(rec, "_ref"))
+}
+
+func (t *dhcpTemplate) create(d *schema.ResourceData, m interface{}) error {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+	}
+
+	obj, err := t.form(d)
+	if err != nil {
+		return err
+	}
+
+	extAttrs, err := terraformDeserializeEAs(d.Get("ext_attrs").(string))
+	if err != nil {
+		return err
+	}
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	obj.data["extattrs"] = extAttrs
+
+	ref, err := m.(ibclient.IBConnector).CreateObject(obj)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %s", t.title, err)
+	}
+
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return err
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+
+	return t.read(d, m)
+}
+
+func (t *dhcpTemplate) read(d *schema.ResourceData, m interface{}) error {
+	extAttrs, err := terraformDeserializeEAs(d.Get("ext_attrs").(string))
+	if err != nil {
+		return err
+	}
+
+	var rec map[string]interface{}
+	err = searchGenericObjectByRefOrInternalId(t.newEmptyObject(), d, m, &rec)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if err = t.setResourceFields(d, rec); err != nil {
+		return err
+	}
+
+	var ea ibclient.EA
+	if err = convertWapiObject(rec["extattrs"], &ea); err != nil {
+		return err
+	}
+	delete(ea, eaNameForInternalId)
+	omittedEAs := omitEAs(ea, extAttrs)
+
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(wapiString(rec, "_ref"))
+
+	return nil
+}
+
+func (t *dhcpTemplate) update(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			fields := []string{"name", "comment", "options", "ext_attrs"}
+			for field := range t.fields {
+				fields = append(fields, field)
+			}
+			for _, field := range fields {
+				prevVal, _ := d.GetChange(field)
+				_ = d.Set(field, prevVal)
+			}
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+	}
+
+	obj, err := t.form(d)
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
+
+	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	var current struct {
+		Ref string      `json:"_ref"`
+		Ea  ibclient.EA `json:"extattrs"`
+	}
+	err = searchGenericObjectByRefOrInternalId(t.newEmptyObject(), d, m, &current)
+	if err != nil {
+		return fmt.Errorf("failed to read %s for update operation: %w", t.title, err)
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	obj.data["extattrs"], err = mergeEAs(current.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return err
+	}
+
+	ref, err := connector.UpdateObject(obj, current.Ref)
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %s", t.title, err)
+	}
+	updateSuccessful = true
+
+	d.SetId(ref)
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return err
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+
+	return t.read(d, m)
+}
+
+func (t *dhcpTemplate) delete(d *schema.ResourceData, m interface{}) error {
+	var current struct {
+		Ref string `json:"_ref"`
+	}
+	err := searchGenericObjectByRefOrInternalId(t.newEmptyObject(), d, m, &current)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if _, err = m.(ibclient.IBConnector).DeleteObject(current.Ref); err != nil {
+		return fmt.Errorf("failed to delete %s: %s", t.title, err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func (t *dhcpTemplate) importState(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(ibclient.IBConnector)
+
+	var rec map[string]interface{}
+	if err := connector.GetObject(t.newEmptyObject(), d.Id(), ibclient.NewQueryParams(false, nil), &rec); err != nil {
+		return nil, fmt.Errorf("failed getting %s: %w", t.title, err)
+	}
+
+	if err := t.setResourceFields(d, rec); err != nil {
+		return nil, err
+	}
+
+	var ea ibclient.EA
+	if err := convertWapiObject(rec["extattrs"], &ea); err != nil {
+		return nil, err
+	}
+	if len(ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(ea)
+		if err != nil {
+			return nil, err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(wapiString(rec, "_ref"))
+
+	// Update the resource with the EA Terraform Internal ID
+	if err := t.update(d, m); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,121 @@
+package infoblox
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/net/idna"
+)
+
+// dnsNameProfile converts internationalized domain names (IDN) the way resolvers do,
+// but allows the labels which are common in DNS data and are not host names, like '_sip' or '*'.
+var dnsNameProfile = idna.New(
+	idna.MapForLookup(),
+	idna.StrictDomainName(false),
+	idna.Transitional(false))
+
+// normalizeDnsName returns the canonical form of a DNS name: in lower case, without the trailing dot,
+// with internationalized labels converted to their ASCII form (punycode).
+// The values which are not valid domain names, like reverse zones' CIDRs, are only lower-cased.
+func normalizeDnsName(name string) string {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" {
+		return ""
+	}
+
+	asciiName, err := dnsNameProfile.ToASCII(name)
+	if err != nil {
+		return strings.ToLower(name)
+	}
+
+	return strings.ToLower(asciiName)
+}
+
+// dnsNameToUnicode returns the form of a DNS name with internationalized labels in Unicode,
+// otherwise the same as the canonical one.
+func dnsNameToUnicode(name string) string {
+	name = normalizeDnsName(name)
+
+	unicodeName, err := dnsNameProfile.ToUnicode(name)
+	if err != nil {
+		return name
+	}
+
+	return unicodeName
+}
+
+// dnsNameForWapi returns a DNS name in the form to pass to NIOS: without the trailing dot
+// and, if the name is internationalized, in its ASCII form, which NIOS and its client accept.
+// The case of ASCII names is kept, as NIOS keeps it for some of the fields.
+func dnsNameForWapi(name string) string {
+	for i := 0; i < len(name); i++ {
+		if name[i] >= utf8.RuneSelf {
+			return normalizeDnsName(name)
+		}
+	}
+
+	return strings.TrimSuffix(name, ".")
+}
+
+// suppressDnsNameDiff suppresses the difference between the forms of the same DNS name,
+// like 'Example.COM.' and 'example.com', or an internationalized name and its ASCII form.
+func suppressDnsNameDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeDnsName(old) == normalizeDnsName(new)
+}
+
+// dnsNameAsciiSchema returns the schema of the computed field which holds the ASCII form
+// of a resource's DNS name field; the field is named after it with '_ascii' suffix.
+func dnsNameAsciiSchema(field string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+		Description: "The value of '" + field + "' field in the canonical ASCII form: in lower case, " +
+			"without the trailing dot, with internationalized labels in punycode.",
+	}
+}
+
+// dnsNameUnicodeSchema returns the schema of the computed field which holds the Unicode form
+// of a resource's DNS name field; the field is named after it with '_unicode' suffix.
+func dnsNameUnicodeSchema(field string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+		Description: "The value of '" + field + "' field in lower case, " +
+			"without the trailing dot, with internationalized labels in Unicode.",
+	}
+}
+
+// dnsNameFormsCustomizeDiff plans the values of the fields which hold the forms of a DNS name field.
+func dnsNameFormsCustomizeDiff(d *schema.ResourceDiff, field string) error {
+	if !d.NewValueKnown(field) {
+		if err := d.SetNewComputed(field + "_ascii"); err != nil {
+			return err
+		}
+		return d.SetNewComputed(field + "_unicode")
+	}
+
+	name := d.Get(field).(string)
+	if d.Get(field+"_ascii").(string) != normalizeDnsName(name) {
+		if err := d.SetNew(field+"_ascii", normalizeDnsName(name)); err != nil {
+			return err
+		}
+	}
+	if d.Get(field+"_unicode").(string) != dnsNameToUnicode(name) {
+		if err := d.SetNew(field+"_unicode", dnsNameToUnicode(name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setDnsNameForms sets the fields which hold the forms of a DNS name field out of its value.
+func setDnsNameForms(d *schema.ResourceData, field string) error {
+	name := d.Get(field).(string)
+	if err := d.Set(field+"_ascii", normalizeDnsName(name)); err != nil {
+		return err
+	}
+
+	return d.Set(field+"_unicode", dnsNameToUnicode(name))
+}
@@ -0,0 +1,89 @@
+package infoblox
+
+import (
+	"testing"
+)
+
+func TestNormalizeDnsName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"", ""},
+		{".", ""},
+		{"example.com", "example.com"},
+		{"Example.COM.", "example.com"},
+		{"www.example.com.", "www.example.com"},
+		{"_sip._tcp.Example.com", "_sip._tcp.example.com"},
+		{"*.example.com", "*.example.com"},
+		{"bücher.example", "xn--bcher-kva.example"},
+		{"Bücher.Example.", "xn--bcher-kva.example"},
+		{"xn--bcher-kva.example", "xn--bcher-kva.example"},
+		{"XN--BCHER-KVA.example.", "xn--bcher-kva.example"},
+		{"MÜNCHEN.de", "xn--mnchen-3ya.de"},
+		{"10.0.0.0/24", "10.0.0.0/24"},
+		{"0.0.10.In-Addr.Arpa.", "0.0.10.in-addr.arpa"},
+	}
+
+	for _, tt := range tests {
+		if actual := normalizeDnsName(tt.name); actual != tt.expected {
+			t.Errorf("normalizeDnsName(%q) = %q, expected %q", tt.name, actual, tt.expected)
+		}
+	}
+}
+
+func TestDnsNameToUnicode(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"", ""},
+		{"Example.COM.", "example.com"},
+		{"xn--bcher-kva.example.", "bücher.example"},
+		{"Bücher.Example", "bücher.example"},
+		{"_sip._tcp.xn--mnchen-3ya.de", "_sip._tcp.münchen.de"},
+	}
+
+	for _, tt := range tests {
+		if actual := dnsNameToUnicode(tt.name); actual != tt.expected {
+			t.Errorf("dnsNameToUnicode(%q) = %q, expected %q", tt.name, actual, tt.expected)
+		}
+	}
+}
+
+func TestDnsNameForWapi(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"", ""},
+		{"example.com.", "example.com"},
+		// The case of ASCII names is kept.
+		{"Example.COM.", "Example.COM"},
+		{"xn--bcher-kva.example.", "xn--bcher-kva.example"},
+		{"Bücher.Example.", "xn--bcher-kva.example"},
+		{"_sip._tcp.münchen.de", "_sip._tcp.xn--mnchen-3ya.de"},
+	}
+
+	for _, tt := range tests {
+		if actual := dnsNameForWapi(tt.name); actual != tt.expected {
+			t.Errorf("dnsNameForWapi(%q) = %q, expected %q", tt.name, actual, tt.expected)
+		}
+	}
+}
+
+func TestDnsNameRoundTrip(t *testing.T) {
+	for _, name := range []string{"bücher.example", "münchen.de", "例え.テスト", "www.example.com"} {
+		ascii := dnsNameForWapi(name)
+		if unicode := dnsNameToUnicode(ascii); unicode != name {
+			t.Errorf("the Unicode form of %q, sent to NIOS as %q, is %q", name, ascii, unicode)
+		}
+		if !suppressDnsNameDiff("", name, ascii, nil) {
+			t.Errorf("the difference between %q and %q is not suppressed", name, ascii)
+		}
+	}
+
+	if suppressDnsNameDiff("", "example.com", "example.org", nil) {
+		t.Errorf("the difference between different names is suppressed")
+	}
+}
@@ -0,0 +1,212 @@
+package infoblox
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+const (
+	dnssecKeyTypeKsk = "KSK"
+
+	dnskeyProtocol    = 3
+	dnskeyFlagsKsk    = 257
+	dsDigestSha256    = 2
+	dsOwnerClass      = "IN"
+	dsRecordType      = "DS"
+	dsImportOperation = "IMPORT_DS"
+)
+
+// dnssecAlgorithms maps the names of DNSSEC algorithms, as NIOS reports them,
+// to the numbers registered by IANA.
+var dnssecAlgorithms = map[string]uint8{
+	"RSAMD5":          1,
+	"DH":              2,
+	"DSA":             3,
+	"RSASHA1":         5,
+	"NSEC3DSA":        6,
+	"NSEC3RSASHA1":    7,
+	"RSASHA256":       8,
+	"RSASHA512":       10,
+	"ECDSAP256SHA256": 13,
+	"ECDSAP384SHA384": 14,
+	"ED25519":         15,
+	"ED448":           16,
+}
+
+// dnssecDigestTypes maps the names of DS digest types to their numbers.
+var dnssecDigestTypes = map[string]uint8{
+	"SHA1":   1,
+	"SHA256": 2,
+	"SHA384": 4,
+}
+
+// dnssecSigningAlgorithms lists the algorithms NIOS may use to sign a zone.
+var dnssecSigningAlgorithms = []string{
+	"RSASHA1", "NSEC3RSASHA1", "RSASHA256", "RSASHA512", "ECDSAP256SHA256", "ECDSAP384SHA384",
+}
+
+// dnssecNumber converts either a number or a name from 'names' into a number.
+func dnssecNumber(value string, names map[string]uint8) (uint8, error) {
+	if n, err := strconv.ParseUint(value, 10, 8); err == nil {
+		return uint8(n), nil
+	}
+	if n, found := names[strings.ToUpper(value)]; found {
+		return n, nil
+	}
+
+	return 0, fmt.Errorf("unknown value: '%s'", value)
+}
+
+// dsRecord is a DS resource record of a delegation.
+type dsRecord struct {
+	owner      string
+	keyTag     uint16
+	algorithm  uint8
+	digestType uint8
+	digest     string
+}
+
+// String returns the record in the master file format.
+func (r *dsRecord) String() string {
+	return fmt.Sprintf("%s %s %s %d %d %d %s",
+		r.owner, dsOwnerClass, dsRecordType, r.keyTag, r.algorithm, r.digestType, r.digest)
+}
+
+// parseDsRecord parses a DS record in the master file format ('<owner> [<ttl>] [IN] DS <key tag> <algorithm>
+// <digest type> <digest>'); the result is normalized, so the records which differ only
+// in their notation are converted into the same value.
+func parseDsRecord(s string) (*dsRecord, error) {
+	fields := strings.Fields(s)
+
+	dsIdx := -1
+	for i, f := range fields {
+		if strings.EqualFold(f, dsRecordType) {
+			dsIdx = i
+			break
+		}
+	}
+	if dsIdx < 1 || len(fields) < dsIdx+5 {
+		return nil, fmt.Errorf("'%s' is not a valid DS record, the format is "+
+			"'<owner> [<ttl>] [IN] DS <key tag> <algorithm> <digest type> <digest>'", s)
+	}
+
+	keyTag, err := strconv.ParseUint(fields[dsIdx+1], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key tag in DS record '%s': %w", s, err)
+	}
+	algorithm, err := dnssecNumber(fields[dsIdx+2], dnssecAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("invalid algorithm in DS record '%s': %w", s, err)
+	}
+	digestType, err := dnssecNumber(fields[dsIdx+3], dnssecDigestTypes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid digest type in DS record '%s': %w", s, err)
+	}
+	digest := strings.ToUpper(strings.Join(fields[dsIdx+4:], ""))
+	if _, err = hex.DecodeString(digest); err != nil {
+		return nil, fmt.Errorf("invalid digest in DS record '%s': %w", s, err)
+	}
+
+	return &dsRecord{
+		owner:      strings.ToLower(strings.TrimSuffix(fields[0], ".")) + ".",
+		keyTag:     uint16(keyTag),
+		algorithm:  algorithm,
+		digestType: digestType,
+		digest:     digest,
+	}, nil
+}
+
+// normalizeDsRecord returns the DS record in the canonical notation,
+// or the value as is if it is not a valid DS record.
+func normalizeDsRecord(s string) string {
+	rec, err := parseDsRecord(s)
+	if err != nil {
+		return s
+	}
+	return rec.String()
+}
+
+// dnsNameToWire converts a domain name to the DNS wire format, in lower case.
+func dnsNameToWire(name string) []byte {
+	var wire []byte
+	for _, label := range strings.Split(strings.TrimSuffix(strings.ToLower(name), "."), ".") {
+		if label == "" {
+			continue
+		}
+		wire = append(wire, byte(len(label)))
+		wire = append(wire, label...)
+	}
+	return append(wire, 0)
+}
+
+// dnskeyTag calculates the tag of a DNSKEY record, as per RFC 4034, Appendix B.
+func dnskeyTag(rdata []byte) uint16 {
+	var acc uint32
+	for i, b := range rdata {
+		if i&1 == 0 {
+			acc += uint32(b) << 8
+		} else {
+			acc += uint32(b)
+		}
+	}
+	acc += acc >> 16 & 0xFFFF
+	return uint16(acc & 0xFFFF)
+}
+
+// dsRecordFromKey calculates the SHA-256 DS record of the zone's key signing key.
+func dsRecordFromKey(zone string, key *ibclient.Dnsseckey) (*dsRecord, error) {
+	algorithm, err := dnssecNumber(key.Algorithm, dnssecAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("key %d: %w", key.Tag, err)
+	}
+	publicKey, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(key.PublicKey), ""))
+	if err != nil {
+		return nil, fmt.Errorf("key %d: cannot decode the public key: %w", key.Tag, err)
+	}
+
+	rdata := make([]byte, 4, 4+len(publicKey))
+	binary.BigEndian.PutUint16(rdata, dnskeyFlagsKsk)
+	rdata[2] = dnskeyProtocol
+	rdata[3] = algorithm
+	rdata = append(rdata, publicKey...)
+
+	digest := sha256.Sum256(append(dnsNameToWire(zone), rdata...))
+
+	keyTag := uint16(key.Tag)
+	if keyTag == 0 {
+		keyTag = dnskeyTag(rdata)
+	}
+
+	return &dsRecord{
+		owner:      strings.ToLower(strings.TrimSuffix(zone, ".")) + ".",
+		keyTag:     keyTag,
+		algorithm:  algorithm,
+		digestType: dsDigestSha256,
+		digest:     strings.ToUpper(hex.EncodeToString(digest[:])),
+	}, nil
+}
+
+// dsRecordsFromKeys returns the DS records which the parent zone must publish
+// for the active and pre-published key signing keys of the zone.
+func dsRecordsFromKeys(zone string, keys []*ibclient.Dnsseckey) ([]string, error) {
+	records := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key == nil || key.Type != dnssecKeyTypeKsk || (key.Status != "ACTIVE" && key.Status != "PUBLISHED") {
+			continue
+		}
+		rec, err := dsRecordFromKey(zone, key)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rec.String())
+	}
+
+	return records, nil
+}
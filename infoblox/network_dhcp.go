@@ -0,0 +1,600 @@
+package infoblox
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// networkDhcpMembersSchema, networkDhcpOptionsSchema, networkDdnsSchema, networkPxeSchema
+// and networkDhcpFailoverSchema are the fields of the network resources with the DHCP settings of the network.
+// The settings which are not set are inherited from the upper levels (the network view, the member, the Grid)
+// and are not reported.
+var (
+	networkDhcpMembersSchema = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Description: "The names of the Grid members which serve DHCP for the network. " +
+			"If not set, the members of the network are not changed.",
+	}
+	networkDhcpOptionsSchema = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "The DHCP options of the network which override the inherited ones; " +
+			"the options which are not set are inherited.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"routers": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Description: "The IP addresses of the routers, IPv4 networks only. " +
+						"If not set, the option follows 'gateway' field.",
+				},
+				"domain_name_servers": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The IP addresses of the DNS servers.",
+				},
+				"domain_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The domain name of the DHCP clients.",
+				},
+				"lease_time": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Description: "The lease time, in seconds; for IPv6 networks this is the valid lifetime " +
+						"of the addresses.",
+				},
+			},
+		},
+	}
+	networkDhcpFailoverSchema = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		Description: "The name of the DHCP failover association which serves the DHCP ranges of the network, " +
+			"IPv4 networks only; the Grid members of the association must serve DHCP for the network. " +
+			"If not set, the DHCP servers of the ranges are not changed.",
+	}
+	networkDdnsSchema = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "The DDNS settings of the network which override the inherited ones; " +
+			"the settings are inherited if the block is not set.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enable": {
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "Determines whether the DHCP server sends DDNS updates for the network.",
+				},
+				"domain_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The domain name of the DDNS updates; inherited if not set.",
+				},
+				"ttl": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The TTL of the DDNS updates, in seconds; inherited if not set or 0.",
+				},
+				"generate_hostname": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Determines whether the DHCP server generates a host name for the clients which send none.",
+				},
+				"update_fixed_addresses": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Determines whether DDNS updates are sent for the fixed addresses, IPv4 networks only.",
+				},
+			},
+		},
+	}
+	networkPxeSchema = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "The PXE boot settings of the network which override the inherited ones, IPv4 networks only; " +
+			"the settings which are not set are inherited.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bootfile": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The name of the boot file the DHCP clients load.",
+				},
+				"bootserver": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The name or the IP address of the server the boot file is loaded from.",
+				},
+				"nextserver": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The name or the IP address of the next server in the boot process.",
+				},
+				"lease_time": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The lease time of the PXE clients, in seconds.",
+				},
+			},
+		},
+	}
+)
+
+// networkDhcpFields are the fields of the network resources with the DHCP settings.
+var networkDhcpFields = []string{"dhcp_members", "dhcp_options", "ddns", "pxe", "dhcp_failover_association"}
+
+// networkDhcpData holds the DHCP settings of 'network' and 'ipv6network' WAPI objects.
+type networkDhcpData struct {
+	Members []struct {
+		Struct string `json:"_struct"`
+		Name   string `json:"name"`
+	} `json:"members"`
+	Options []*ibclient.Dhcpoption `json:"options"`
+
+	EnableDdns                  bool   `json:"enable_ddns"`
+	UseEnableDdns               bool   `json:"use_enable_ddns"`
+	DdnsDomainname              string `json:"ddns_domainname"`
+	UseDdnsDomainname           bool   `json:"use_ddns_domainname"`
+	DdnsTtl                     int    `json:"ddns_ttl"`
+	UseDdnsTtl                  bool   `json:"use_ddns_ttl"`
+	DdnsGenerateHostname        bool   `json:"ddns_generate_hostname"`
+	DdnsUpdateFixedAddresses    bool   `json:"ddns_update_fixed_addresses"`
+	UseDdnsUpdateFixedAddresses bool   `json:"use_ddns_update_fixed_addresses"`
+
+	Bootfile        string `json:"bootfile"`
+	UseBootfile     bool   `json:"use_bootfile"`
+	Bootserver      string `json:"bootserver"`
+	UseBootserver   bool   `json:"use_bootserver"`
+	Nextserver      string `json:"nextserver"`
+	UseNextserver   bool   `json:"use_nextserver"`
+	PxeLeaseTime    int    `json:"pxe_lease_time"`
+	UsePxeLeaseTime bool   `json:"use_pxe_lease_time"`
+
+	DomainName           string   `json:"domain_name"`
+	UseDomainName        bool     `json:"use_domain_name"`
+	DomainNameServers    []string `json:"domain_name_servers"`
+	UseDomainNameServers bool     `json:"use_domain_name_servers"`
+	ValidLifetime        int      `json:"valid_lifetime"`
+	UseValidLifetime     bool     `json:"use_valid_lifetime"`
+}
+
+// networkDhcpReturnFields returns the fields of the network's WAPI object with the DHCP settings.
+func networkDhcpReturnFields(isIPv6 bool) []string {
+	fields := []string{
+		"members", "options", "enable_ddns", "use_enable_ddns", "ddns_domainname", "use_ddns_domainname",
+		"ddns_ttl", "use_ddns_ttl", "ddns_generate_hostname",
+	}
+	if isIPv6 {
+		return append(fields,
+			"domain_name", "use_domain_name", "domain_name_servers", "use_domain_name_servers",
+			"valid_lifetime", "use_valid_lifetime")
+	}
+
+	return append(fields,
+		"ddns_update_fixed_addresses", "use_ddns_update_fixed_addresses",
+		"bootfile", "use_bootfile", "bootserver", "use_bootserver", "nextserver", "use_nextserver",
+		"pxe_lease_time", "use_pxe_lease_time")
+}
+
+// networkDhcpBlock returns the content of the block field of the resource, nil if the block is not set.
+func networkDhcpBlock(d *schema.ResourceData, field string) map[string]interface{} {
+	block := d.Get(field).([]interface{})
+	if len(block) == 0 || block[0] == nil {
+		return nil
+	}
+
+	return block[0].(map[string]interface{})
+}
+
+func convertStringList(list interface{}) []string {
+	res := make([]string, 0)
+	for _, v := range list.([]interface{}) {
+		if s, ok := v.(string); ok && s != "" {
+			res = append(res, s)
+		}
+	}
+
+	return res
+}
+
+// checkNetworkDhcpFields checks that the DHCP settings of the resource apply to the network's IP version.
+func checkNetworkDhcpFields(d *schema.ResourceData, isIPv6 bool) error {
+	if !isIPv6 {
+		return nil
+	}
+	if opts := networkDhcpBlock(d, "dhcp_options"); opts != nil && len(convertStringList(opts["routers"])) > 0 {
+		return fmt.Errorf("'routers' DHCP option is not supported by IPv6 networks")
+	}
+	if ddns := networkDhcpBlock(d, "ddns"); ddns != nil && ddns["update_fixed_addresses"].(bool) {
+		return fmt.Errorf("'update_fixed_addresses' DDNS setting is not supported by IPv6 networks")
+	}
+	if networkDhcpBlock(d, "pxe") != nil {
+		return fmt.Errorf("'pxe' settings are not supported by IPv6 networks")
+	}
+	if d.Get("dhcp_failover_association").(string) != "" {
+		return fmt.Errorf("'dhcp_failover_association' is not supported by IPv6 networks")
+	}
+
+	return nil
+}
+
+// networkDhcpOptions returns the DHCP options of the IPv4 network, based on its current options:
+// 'routers' option is set to the routers of 'dhcp_options' block or, if there are none, to the gateway's
+// IP address (no option if the network has no gateway); the other options of the block override
+// the inherited ones, when they are set, and are removed (so inherited) otherwise.
+func networkDhcpOptions(current []*ibclient.Dhcpoption, d *schema.ResourceData, gateway string) []*ibclient.Dhcpoption {
+	managed := map[string]bool{"routers": true}
+	opts := networkDhcpBlock(d, "dhcp_options")
+	if opts != nil || d.HasChange("dhcp_options") {
+		managed["domain-name-servers"] = true
+		managed["domain-name"] = true
+		managed["dhcp-lease-time"] = true
+	}
+
+	options := make([]*ibclient.Dhcpoption, 0, len(current)+4)
+	for _, opt := range current {
+		if opt != nil && !managed[opt.Name] {
+			options = append(options, opt)
+		}
+	}
+	addOption := func(name string, value string) {
+		options = append(options, &ibclient.Dhcpoption{Name: name, Value: value, UseOption: true})
+	}
+
+	routers := ""
+	if gateway != "none" {
+		routers = gateway
+	}
+	if opts != nil {
+		if r := convertStringList(opts["routers"]); len(r) > 0 {
+			routers = strings.Join(r, ",")
+		}
+		if servers := convertStringList(opts["domain_name_servers"]); len(servers) > 0 {
+			addOption("domain-name-servers", strings.Join(servers, ","))
+		}
+		if domainName := opts["domain_name"].(string); domainName != "" {
+			addOption("domain-name", domainName)
+		}
+		if leaseTime := opts["lease_time"].(int); leaseTime > 0 {
+			addOption("dhcp-lease-time", strconv.Itoa(leaseTime))
+		}
+	}
+	if routers != "" {
+		addOption("routers", routers)
+	}
+
+	return options
+}
+
+// networkDhcpUpdateData returns the fields of the network's WAPI object which apply the DHCP settings
+// of the resource: the ones which are set at creation, the changed ones otherwise. The options of IPv4 networks
+// are set separately, by networkDhcpOptions.
+func networkDhcpUpdateData(d *schema.ResourceData, isIPv6 bool, create bool) map[string]interface{} {
+	changed := func(field string) bool {
+		if !create {
+			return d.HasChange(field)
+		}
+		if field == "dhcp_members" {
+			return !d.GetRawConfig().GetAttr(field).IsNull()
+		}
+		return networkDhcpBlock(d, field) != nil
+	}
+
+	data := make(map[string]interface{})
+	if changed("dhcp_members") {
+		members := make([]map[string]interface{}, 0)
+		for _, name := range convertStringList(d.Get("dhcp_members")) {
+			members = append(members, map[string]interface{}{"_struct": "dhcpmember", "name": name})
+		}
+		data["members"] = members
+	}
+
+	if isIPv6 && changed("dhcp_options") {
+		opts := networkDhcpBlock(d, "dhcp_options")
+		var (
+			domainName string
+			servers    = make([]string, 0)
+			leaseTime  int
+		)
+		if opts != nil {
+			domainName = opts["domain_name"].(string)
+			servers = convertStringList(opts["domain_name_servers"])
+			leaseTime = opts["lease_time"].(int)
+		}
+		data["use_domain_name"] = domainName != ""
+		if domainName != "" {
+			data["domain_name"] = domainName
+		}
+		data["use_domain_name_servers"] = len(servers) > 0
+		if len(servers) > 0 {
+			data["domain_name_servers"] = servers
+		}
+		data["use_valid_lifetime"] = leaseTime > 0
+		if leaseTime > 0 {
+			data["valid_lifetime"] = leaseTime
+		}
+	}
+
+	if changed("ddns") {
+		ddns := networkDhcpBlock(d, "ddns")
+		data["use_enable_ddns"] = ddns != nil
+		data["use_ddns_domainname"] = ddns != nil && ddns["domain_name"].(string) != ""
+		data["use_ddns_ttl"] = ddns != nil && ddns["ttl"].(int) > 0
+		data["use_ddns_generate_hostname"] = ddns != nil
+		if !isIPv6 {
+			data["use_ddns_update_fixed_addresses"] = ddns != nil
+		}
+		if ddns != nil {
+			data["enable_ddns"] = ddns["enable"].(bool)
+			data["ddns_generate_hostname"] = ddns["generate_hostname"].(bool)
+			if domainName := ddns["domain_name"].(string); domainName != "" {
+				data["ddns_domainname"] = domainName
+			}
+			if ttl := ddns["ttl"].(int); ttl > 0 {
+				data["ddns_ttl"] = ttl
+			}
+			if !isIPv6 {
+				data["ddns_update_fixed_addresses"] = ddns["update_fixed_addresses"].(bool)
+			}
+		}
+	}
+
+	if !isIPv6 && changed("pxe") {
+		pxe := networkDhcpBlock(d, "pxe")
+		for _, field := range []string{"bootfile", "bootserver", "nextserver"} {
+			value := ""
+			if pxe != nil {
+				value = pxe[field].(string)
+			}
+			data["use_"+field] = value != ""
+			if value != "" {
+				data[field] = value
+			}
+		}
+		leaseTime := 0
+		if pxe != nil {
+			leaseTime = pxe["lease_time"].(int)
+		}
+		data["use_pxe_lease_time"] = leaseTime > 0
+		if leaseTime > 0 {
+			data["enable_pxe_lease_time"] = true
+			data["pxe_lease_time"] = leaseTime
+		}
+	}
+
+	return data
+}
+
+// getNetworkDhcpData returns the DHCP settings of the network with the reference 'ref'.
+func getNetworkDhcpData(connector ibclient.IBConnector, ref string, isIPv6 bool) (*networkDhcpData, error) {
+	objType := strings.SplitN(ref, "/", 2)[0]
+	var res networkDhcpData
+	err := connector.GetObject(
+		newWapiObject(objType, networkDhcpReturnFields(isIPv6)), ref, ibclient.NewQueryParams(false, nil), &res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the DHCP settings of the network: %w", err)
+	}
+
+	return &res, nil
+}
+
+// setNetworkDhcpFields sets the DHCP settings of the resource: only the overridden settings are reported,
+// the blocks are reported if any of their settings is overridden or if they are set for the resource.
+// The settings of a network created out of a template are overridden by the template, so its blocks
+// are reported only if they are set for the resource.
+func setNetworkDhcpFields(d *schema.ResourceData, dhcp *networkDhcpData, isIPv6 bool) error {
+	fromTemplate := d.Get("template").(string) != ""
+	members := make([]string, 0, len(dhcp.Members))
+	for _, member := range dhcp.Members {
+		if member.Struct == "dhcpmember" {
+			members = append(members, member.Name)
+		}
+	}
+	if err := d.Set("dhcp_members", members); err != nil {
+		return err
+	}
+
+	var (
+		routers, servers      = make([]string, 0), make([]string, 0)
+		domainName            string
+		leaseTime             int
+		optionsOverridden     bool
+		routersSetForResource bool
+	)
+	if opts := networkDhcpBlock(d, "dhcp_options"); opts != nil {
+		routersSetForResource = len(convertStringList(opts["routers"])) > 0
+	}
+	splitList := func(value string) []string {
+		res := make([]string, 0)
+		for _, s := range strings.Split(value, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				res = append(res, s)
+			}
+		}
+		return res
+	}
+	if isIPv6 {
+		if dhcp.UseDomainName {
+			domainName, optionsOverridden = dhcp.DomainName, true
+		}
+		if dhcp.UseDomainNameServers && dhcp.DomainNameServers != nil {
+			servers, optionsOverridden = dhcp.DomainNameServers, true
+		}
+		if dhcp.UseValidLifetime {
+			leaseTime, optionsOverridden = dhcp.ValidLifetime, true
+		}
+	} else {
+		for _, opt := range dhcp.Options {
+			if opt == nil || !opt.UseOption {
+				continue
+			}
+			switch opt.Name {
+			case "routers":
+				// The routers which follow the gateway are not reported.
+				if routersSetForResource {
+					routers, optionsOverridden = splitList(opt.Value), true
+				}
+			case "domain-name-servers":
+				servers, optionsOverridden = splitList(opt.Value), true
+			case "domain-name":
+				domainName, optionsOverridden = opt.Value, true
+			case "dhcp-lease-time":
+				leaseTime, _ = strconv.Atoi(opt.Value)
+				optionsOverridden = true
+			}
+		}
+	}
+	var options []interface{}
+	if (optionsOverridden && !fromTemplate) || networkDhcpBlock(d, "dhcp_options") != nil {
+		options = []interface{}{map[string]interface{}{
+			"routers":             routers,
+			"domain_name_servers": servers,
+			"domain_name":         domainName,
+			"lease_time":          leaseTime,
+		}}
+	}
+	if err := d.Set("dhcp_options", options); err != nil {
+		return err
+	}
+
+	var ddns []interface{}
+	if (dhcp.UseEnableDdns && !fromTemplate) || networkDhcpBlock(d, "ddns") != nil {
+		block := map[string]interface{}{
+			"enable":            dhcp.EnableDdns,
+			"generate_hostname": dhcp.DdnsGenerateHostname,
+		}
+		if dhcp.UseDdnsDomainname {
+			block["domain_name"] = dhcp.DdnsDomainname
+		}
+		if dhcp.UseDdnsTtl {
+			block["ttl"] = dhcp.DdnsTtl
+		}
+		if !isIPv6 {
+			block["update_fixed_addresses"] = dhcp.DdnsUpdateFixedAddresses
+		}
+		ddns = []interface{}{block}
+	}
+	if err := d.Set("ddns", ddns); err != nil {
+		return err
+	}
+
+	if isIPv6 {
+		return nil
+	}
+	var pxe []interface{}
+	pxeOverridden := dhcp.UseBootfile || dhcp.UseBootserver || dhcp.UseNextserver || dhcp.UsePxeLeaseTime
+	if (pxeOverridden && !fromTemplate) || networkDhcpBlock(d, "pxe") != nil {
+
+		block := make(map[string]interface{})
+		if dhcp.UseBootfile {
+			block["bootfile"] = dhcp.Bootfile
+		}
+		if dhcp.UseBootserver {
+			block["bootserver"] = dhcp.Bootserver
+		}
+		if dhcp.UseNextserver {
+			block["nextserver"] = dhcp.Nextserver
+		}
+		if dhcp.UsePxeLeaseTime {
+			block["lease_time"] = dhcp.PxeLeaseTime
+		}
+		pxe = []interface{}{block}
+	}
+
+	return d.Set("pxe", pxe)
+}
+
+// networkDhcpRange is a DHCP range of an IPv4 network, with the DHCP server which serves it.
+type networkDhcpRange struct {
+	Ref                   string `json:"_ref"`
+	ServerAssociationType string `json:"server_association_type"`
+	FailoverAssociation   string `json:"failover_association"`
+}
+
+// getNetworkDhcpRanges returns the DHCP ranges of the IPv4 network.
+func getNetworkDhcpRanges(connector ibclient.IBConnector, networkView, cidr string) ([]networkDhcpRange, error) {
+	sf := map[string]string{
+		"network":      cidr,
+		"network_view": networkView,
+	}
+	var ranges []networkDhcpRange
+	err := connector.GetObject(
+		newWapiObject("range", []string{"server_association_type", "failover_association"}), "",
+		ibclient.NewQueryParams(false, sf), &ranges)
+	if err != nil && !isNotFoundError(err) {
+		return nil, fmt.Errorf("failed to get the DHCP ranges of network '%s': %w", cidr, err)
+	}
+
+	return ranges, nil
+}
+
+// setNetworkDhcpFailoverField sets the failover association of the resource to the one which serves
+// all the DHCP ranges of the network, to an empty value if the ranges are served otherwise.
+// The value is kept as is if the network has no ranges.
+func setNetworkDhcpFailoverField(d *schema.ResourceData, connector ibclient.IBConnector, networkView, cidr string) error {
+	ranges, err := getNetworkDhcpRanges(connector, networkView, cidr)
+	if err != nil {
+		return err
+	}
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	failover := ranges[0].FailoverAssociation
+	for _, r := range ranges {
+		if r.ServerAssociationType != "FAILOVER" || r.FailoverAssociation != failover {
+			failover = ""
+			break
+		}
+	}
+
+	return d.Set("dhcp_failover_association", failover)
+}
+
+// networkDhcpFailoverItems returns the requests which assign the failover association of the resource
+// to the DHCP ranges of the network which are not served by it yet; none if the association is not set.
+func networkDhcpFailoverItems(
+	d *schema.ResourceData, connector ibclient.IBConnector, networkView, cidr string) ([]wapiRequestItem, error) {
+
+	failover := d.Get("dhcp_failover_association").(string)
+	if failover == "" {
+		return nil, nil
+	}
+	ranges, err := getNetworkDhcpRanges(connector, networkView, cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []wapiRequestItem
+	for _, r := range ranges {
+		if r.ServerAssociationType == "FAILOVER" && r.FailoverAssociation == failover {
+			continue
+		}
+		items = append(items, wapiRequestItem{
+			Method: "PUT",
+			Object: r.Ref,
+			Data: map[string]interface{}{
+				"server_association_type": "FAILOVER",
+				"failover_association":    failover,
+			},
+		})
+	}
+
+	return items, nil
+}
@@ -0,0 +1,257 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// networkCidrChange checks that a network or a network container may be changed from 'oldCidr' to 'newCidr'
+// in place, which is either an expansion to a network which contains the old one
+// or a split (a shrink for network containers) to a network within the old one.
+// The new prefix length is returned, along with whether the change is an expansion.
+func networkCidrChange(oldCidr string, newCidr string) (int, bool, error) {
+	notAllowed := func(reason string) error {
+		return fmt.Errorf(
+			"changing the value of 'cidr' field is not allowed from '%s' to '%s': %s; "+
+				"the network may only be expanded to a network which contains it or split to a network within it, "+
+				"otherwise it must be re-created", oldCidr, newCidr, reason)
+	}
+
+	_, oldNet, err := net.ParseCIDR(oldCidr)
+	if err != nil {
+		return 0, false, notAllowed(err.Error())
+	}
+	newIP, newNet, err := net.ParseCIDR(newCidr)
+	if err != nil {
+		return 0, false, notAllowed(err.Error())
+	}
+	if !newIP.Equal(newNet.IP) {
+		return 0, false, notAllowed(fmt.Sprintf("'%s' is not the address of the network, '%s' is", newIP, newNet.IP))
+	}
+
+	oldOnes, oldBits := oldNet.Mask.Size()
+	newOnes, newBits := newNet.Mask.Size()
+	switch {
+	case oldBits != newBits:
+		return 0, false, notAllowed("the IP address versions differ")
+	case newOnes < oldOnes && newNet.Contains(oldNet.IP):
+		return newOnes, true, nil
+	case newOnes > oldOnes && oldNet.Contains(newNet.IP):
+		return newOnes, false, nil
+	case newOnes == oldOnes:
+		return 0, false, notAllowed("the prefix lengths are the same")
+	}
+
+	return 0, false, notAllowed("the networks do not overlap")
+}
+
+// networkCidrCustomizeDiff reports at plan time the changes of the CIDR of a network or a network container
+// which cannot be done in place.
+func networkCidrCustomizeDiff(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.HasChange("cidr") || !d.NewValueKnown("cidr") {
+		return nil
+	}
+
+	oldCidr, newCidr := d.GetChange("cidr")
+	if oldCidr.(string) == "" || newCidr.(string) == "" {
+		return nil
+	}
+	_, _, err := networkCidrChange(oldCidr.(string), normalizeIPAddress(newCidr))
+
+	return err
+}
+
+// networkJoinedCidrsCustomizeDiff shows at plan time, in 'joined_networks' field, the networks which expanding
+// the network joins into it.
+func networkJoinedCidrsCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("cidr") || !d.NewValueKnown("cidr") {
+		return nil
+	}
+
+	oldCidr, newCidr := d.GetChange("cidr")
+	if oldCidr.(string) == "" || newCidr.(string) == "" {
+		return nil
+	}
+	if _, expand, err := networkCidrChange(oldCidr.(string), normalizeIPAddress(newCidr)); err != nil || !expand {
+		return nil
+	}
+
+	joined, err := networkJoinedCidrs(
+		m.(ibclient.IBConnector), d.Get("network_view").(string), oldCidr.(string), normalizeIPAddress(newCidr))
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("joined_networks", joined)
+}
+
+// networkJoinedCidrs returns the CIDRs of the networks which expanding the network 'oldCidr' to 'newCidr'
+// joins into it: the other networks within the new one, which are in the same network container.
+func networkJoinedCidrs(connector ibclient.IBConnector, networkView string, oldCidr string, newCidr string) ([]string, error) {
+	isIPv6, err := isIPv6Cidr(oldCidr)
+	if err != nil {
+		return nil, err
+	}
+	objType := "network"
+	if isIPv6 {
+		objType = "ipv6network"
+	}
+	_, newNet, err := net.ParseCIDR(newCidr)
+	if err != nil {
+		return nil, err
+	}
+	newOnes, _ := newNet.Mask.Size()
+
+	type networkObj struct {
+		Cidr      string `json:"network"`
+		Container string `json:"network_container"`
+	}
+	returnFields := []string{"network", "network_container"}
+	var orig []networkObj
+	sf := map[string]string{"network_view": networkView, "network": oldCidr}
+	err = connector.GetObject(newWapiObject(objType, returnFields), "", ibclient.NewQueryParams(false, sf), &orig)
+	if err != nil && !isNotFoundError(err) {
+		return nil, fmt.Errorf("failed to get the network '%s' to expand: %w", oldCidr, err)
+	}
+	if len(orig) == 0 {
+		return []string{}, nil
+	}
+
+	var siblings []networkObj
+	sf = map[string]string{"network_view": networkView, "network_container": orig[0].Container}
+	err = connector.GetObject(newWapiObject(objType, returnFields), "", ibclient.NewQueryParams(false, sf), &siblings)
+	if err != nil && !isNotFoundError(err) {
+		return nil, fmt.Errorf("failed to get the networks within '%s': %w", newCidr, err)
+	}
+
+	joined := make([]string, 0)
+	for _, sibling := range siblings {
+		ip, sibNet, err := net.ParseCIDR(sibling.Cidr)
+		if err != nil || sibling.Cidr == oldCidr {
+			continue
+		}
+		if ones, _ := sibNet.Mask.Size(); ones >= newOnes && newNet.Contains(ip) {
+			joined = append(joined, sibling.Cidr)
+		}
+	}
+
+	return joined, nil
+}
+
+// resizeNetwork changes the CIDR of the network or the network container with the reference 'ref' in place,
+// calling 'expand_network' or 'split_network' WAPI functions for networks and 'resize' function for network containers.
+// Expanding a network joins the networks within the new one into it. Splitting a network keeps
+// the new network and those of the other parts which are in use, the latter are no longer related
+// to the resource: the internal ID is removed from their extensible attributes.
+// The reference of the resized object is returned.
+func resizeNetwork(
+	connector ibclient.IBConnector,
+	ref string,
+	networkView string,
+	oldCidr string,
+	newCidr string,
+	isContainer bool) (string, error) {
+
+	newPrefix, expand, err := networkCidrChange(oldCidr, newCidr)
+	if err != nil {
+		return "", err
+	}
+
+	objType := strings.SplitN(ref, "/", 2)[0]
+	var orig struct {
+		Comment string      `json:"comment"`
+		Ea      ibclient.EA `json:"extattrs"`
+	}
+	err = connector.GetObject(newWapiObject(objType, []string{"comment", "extattrs"}), ref, ibclient.NewQueryParams(false, nil), &orig)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the network '%s' to resize it: %w", oldCidr, err)
+	}
+
+	switch {
+	case isContainer:
+		err = callWapiFunction(connector, ref, "resize", map[string]interface{}{"prefix": newPrefix}, nil)
+	case expand:
+		err = callWapiFunction(connector, ref, "expand_network", map[string]interface{}{"prefix": newPrefix}, nil)
+	default:
+		err = callWapiFunction(connector, ref, "split_network", map[string]interface{}{
+			"prefix":              newPrefix,
+			"add_all_subnetworks": false,
+		}, nil)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to change the network '%s' to '%s': %w", oldCidr, newCidr, err)
+	}
+
+	type networkObj struct {
+		Ref  string `json:"_ref"`
+		Cidr string `json:"network"`
+	}
+	var resized []networkObj
+	sf := map[string]string{"network_view": networkView, "network": newCidr}
+	err = connector.GetObject(newWapiObject(objType, []string{"network"}), "", ibclient.NewQueryParams(false, sf), &resized)
+	if err != nil && !isNotFoundError(err) {
+		return "", fmt.Errorf("failed to get the network '%s' after the change: %w", newCidr, err)
+	}
+	if isContainer || expand {
+		if len(resized) == 0 {
+			return "", fmt.Errorf("network '%s' is not found after the change", newCidr)
+		}
+		return resized[0].Ref, nil
+	}
+
+	// The parts of the split network which are not in use are not created, including the new network,
+	// which is created then; the other parts keep the internal ID of the resource, which is removed.
+	var items []wapiRequestItem
+	if len(resized) == 0 {
+		items = append(items, wapiRequestItem{
+			Method: "POST",
+			Object: objType,
+			Data: map[string]interface{}{
+				"network":      newCidr,
+				"network_view": networkView,
+				"comment":      orig.Comment,
+				"extattrs":     orig.Ea,
+			},
+		})
+	}
+	if internalId, found := orig.Ea[eaNameForInternalId]; found {
+		var parts []networkObj
+		sf = map[string]string{"network_view": networkView, "*" + eaNameForInternalId: fmt.Sprintf("%v", internalId)}
+		err = connector.GetObject(newWapiObject(objType, []string{"network"}), "", ibclient.NewQueryParams(false, sf), &parts)
+		if err != nil && !isNotFoundError(err) {
+			return "", fmt.Errorf("failed to get the parts of the split network '%s': %w", oldCidr, err)
+		}
+		for _, part := range parts {
+			if part.Cidr == newCidr {
+				continue
+			}
+			items = append(items, wapiRequestItem{
+				Method: "PUT",
+				Object: part.Ref,
+				Data: map[string]interface{}{
+					"extattrs-": map[string]interface{}{eaNameForInternalId: map[string]interface{}{}},
+				},
+			})
+		}
+	}
+
+	results, err := multiRequest(connector, items)
+	if err != nil {
+		return "", fmt.Errorf("failed to set up the parts of the split network '%s': %w", oldCidr, err)
+	}
+	if len(resized) > 0 {
+		return resized[0].Ref, nil
+	}
+
+	var newRef string
+	if err = json.Unmarshal(results[0], &newRef); err != nil {
+		return "", fmt.Errorf("failed to parse the reference of network '%s': %w", newCidr, err)
+	}
+
+	return newRef, nil
+}
@@ -0,0 +1,109 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// parseNextAvailableFilter parses 'filter_params' field, the extensible attributes which select the network
+// or the network container to allocate from, into the object parameters of the next available network
+// and IP address functions; the network view is added to them.
+func parseNextAvailableFilter(filter string, networkView string) (map[string]string, error) {
+	var eaMap map[string]string
+	if err := json.Unmarshal([]byte(filter), &eaMap); err != nil {
+		return nil, err
+	}
+	if eaMap == nil {
+		eaMap = make(map[string]string)
+	}
+	eaMap["network_view"] = networkView
+
+	return eaMap, nil
+}
+
+// nextAvailableNetworkObjType returns the WAPI object type to allocate the next available network from,
+// a network container unless 'object' is 'network'.
+func nextAvailableNetworkObjType(isIPv6 bool, object string) string {
+	objType := "networkcontainer"
+	if object == "network" {
+		objType = "network"
+	}
+	if isIPv6 {
+		objType = "ipv6" + objType
+	}
+
+	return objType
+}
+
+// newNextAvailableNetworkInfo returns the next available network function which allocates a network
+// with the prefix length out of the object, of type 'objType', with the extensible attributes.
+func newNextAvailableNetworkInfo(
+	objType string, eaMap map[string]string, prefixLen int) *ibclient.NetworkContainerNextAvailableInfo {
+
+	return &ibclient.NetworkContainerNextAvailableInfo{
+		Function:     "next_available_network",
+		ResultField:  "networks",
+		Object:       objType,
+		ObjectParams: eaMap,
+		Params:       map[string]uint{"cidr": uint(prefixLen)},
+	}
+}
+
+// isIPv6Cidr tells whether the CIDR is an IPv6 one.
+func isIPv6Cidr(cidr string) (bool, error) {
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false, err
+	}
+
+	return ip.To4() == nil, nil
+}
+
+// getNextAvailableParent returns the reference of the object of type 'objType' the next available networks
+// or IP addresses are taken from: the one with the CIDR, if it is given, otherwise the first one NIOS finds
+// with the extensible attributes, the same one the next available functions use at allocation.
+func getNextAvailableParent(
+	connector ibclient.IBConnector, objType string, networkView string, cidr string, eaMap map[string]string) (string, error) {
+
+	sf := eaMap
+	if cidr != "" {
+		sf = map[string]string{
+			"network":      cidr,
+			"network_view": networkView,
+		}
+	}
+
+	var parents []struct {
+		Ref string `json:"_ref"`
+	}
+	err := connector.GetObject(newWapiObject(objType, []string{"network"}), "", ibclient.NewQueryParams(false, sf), &parents)
+	if err != nil && !isNotFoundError(err) {
+		return "", fmt.Errorf("failed to search for the objects of type '%s' in network view '%s': %w", objType, networkView, err)
+	}
+	if len(parents) == 0 {
+		if cidr != "" {
+			return "", fmt.Errorf("there is no object of type '%s' with CIDR '%s' in network view '%s'", objType, cidr, networkView)
+		}
+		return "", fmt.Errorf("there is no object of type '%s' with the extensible attributes in network view '%s'", objType, networkView)
+	}
+
+	return parents[0].Ref, nil
+}
+
+// nextAvailableArgs returns the arguments of the next available network and IP address functions:
+// the number of the candidates and the ones to exclude.
+func nextAvailableArgs(num int, exclude []interface{}) map[string]interface{} {
+	args := map[string]interface{}{"num": num}
+	if len(exclude) > 0 {
+		excluded := make([]string, 0, len(exclude))
+		for _, e := range exclude {
+			excluded = append(excluded, e.(string))
+		}
+		args["exclude"] = excluded
+	}
+
+	return args
+}
@@ -8,6 +8,7 @@ import (
 	log "github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"math"
 	"reflect"
@@ -192,52 +193,107 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("POOL_CONNECTIONS", "10"),
 				Description: "Maximum number of connections to establish to the Infoblox server. Zero means unlimited.",
 			},
+			"allocation_lock": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALLOCATION_LOCK", false),
+				Description: "If set, every allocation of the next available network or IP address is done holding " +
+					"a lock on the network view, to serialize the allocations of concurrent Terraform runs.",
+			},
+			"allocation_lock_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALLOCATION_LOCK_TIMEOUT", defaultAllocationLockTimeout),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum wait for the allocation lock held by another run, in seconds.",
+			},
+			"allocation_lock_stale_after": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALLOCATION_LOCK_STALE_AFTER", defaultAllocationLockStaleAfter),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description: "The time, in seconds, after which the allocation lock held by another run is considered " +
+					"stale, as left by a failed run, and is taken over.",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"infoblox_network_view":           resourceNetworkView(),
-			"infoblox_ipv4_network_container": resourceIPv4NetworkContainer(),
-			"infoblox_ipv6_network_container": resourceIPv6NetworkContainer(),
-			"infoblox_ipv4_network":           resourceIPv4Network(),
-			"infoblox_ipv6_network":           resourceIPv6Network(),
-			"infoblox_ip_allocation":          resourceIPAllocation(),
-			"infoblox_ip_association":         resourceIpAssociationInit(),
-			"infoblox_a_record":               resourceARecord(),
-			"infoblox_aaaa_record":            resourceAAAARecord(),
-			"infoblox_cname_record":           resourceCNAMERecord(),
-			"infoblox_ptr_record":             resourcePTRRecord(),
-			"infoblox_zone_delegated":         resourceZoneDelegated(),
-			"infoblox_txt_record":             resourceTXTRecord(),
-			"infoblox_mx_record":              resourceMXRecord(),
-			"infoblox_srv_record":             resourceSRVRecord(),
-			"infoblox_dns_view":               resourceDNSView(),
-			"infoblox_zone_auth":              resourceZoneAuth(),
-			"infoblox_zone_forward":           resourceZoneForward(),
-			"infoblox_dtc_lbdn":               resourceDtcLbdnRecord(),
-			"infoblox_dtc_pool":               resourceDtcPool(),
-			"infoblox_dtc_server":             resourceDtcServer(),
+			"infoblox_network_view":                resourceNetworkView(),
+			"infoblox_ipv4_network_container":      resourceIPv4NetworkContainer(),
+			"infoblox_ipv6_network_container":      resourceIPv6NetworkContainer(),
+			"infoblox_ipv4_network":                resourceIPv4Network(),
+			"infoblox_ipv6_network":                resourceIPv6Network(),
+			"infoblox_ipv4_network_template":       resourceIPv4NetworkTemplate(),
+			"infoblox_ipv6_network_template":       resourceIPv6NetworkTemplate(),
+			"infoblox_ipv4_range_template":         resourceRangeTemplate(),
+			"infoblox_ipv4_fixed_address_template": resourceFixedAddressTemplate(),
+			"infoblox_ip_allocation":               resourceIPAllocation(),
+			"infoblox_ip_association":              resourceIpAssociationInit(),
+			"infoblox_a_record":                    resourceARecord(),
+			"infoblox_aaaa_record":                 resourceAAAARecord(),
+			"infoblox_cname_record":                resourceCNAMERecord(),
+			"infoblox_ptr_record":                  resourcePTRRecord(),
+			"infoblox_zone_delegated":              resourceZoneDelegated(),
+			"infoblox_txt_record":                  resourceTXTRecord(),
+			"infoblox_mx_record":                   resourceMXRecord(),
+			"infoblox_srv_record":                  resourceSRVRecord(),
+			"infoblox_dns_view":                    resourceDNSView(),
+			"infoblox_zone_auth":                   resourceZoneAuth(),
+			"infoblox_zone_forward":                resourceZoneForward(),
+			"infoblox_dtc_lbdn":                    resourceDtcLbdnRecord(),
+			"infoblox_dtc_pool":                    resourceDtcPool(),
+			"infoblox_dtc_server":                  resourceDtcServer(),
+			"infoblox_unknown_record":              resourceUnknownRecord(),
+			"infoblox_shared_record_group":         resourceSharedRecordGroup(),
+			"infoblox_shared_record_a":             resourceSharedRecordA(),
+			"infoblox_shared_record_aaaa":          resourceSharedRecordAAAA(),
+			"infoblox_shared_record_cname":         resourceSharedRecordCNAME(),
+			"infoblox_shared_record_mx":            resourceSharedRecordMX(),
+			"infoblox_shared_record_srv":           resourceSharedRecordSRV(),
+			"infoblox_shared_record_txt":           resourceSharedRecordTXT(),
+			"infoblox_zone_stub":                   resourceZoneStub(),
+			"infoblox_zone_rp":                     resourceZoneRp(),
+			"infoblox_rpz_rule":                    resourceRpzRule(),
+			"infoblox_rpz_rule_a":                  resourceRpzRuleA(),
+			"infoblox_rpz_rule_aaaa":               resourceRpzRuleAAAA(),
+			"infoblox_rpz_rule_mx":                 resourceRpzRuleMX(),
+			"infoblox_rpz_rule_ptr":                resourceRpzRulePTR(),
+			"infoblox_rpz_rule_srv":                resourceRpzRuleSRV(),
+			"infoblox_rpz_rule_txt":                resourceRpzRuleTXT(),
+			"infoblox_rpz_rule_naptr":              resourceRpzRuleNAPTR(),
+			"infoblox_named_acl":                   resourceNamedAcl(),
+			"infoblox_zone_import":                 resourceZoneImport(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"infoblox_ipv4_network":           dataSourceIPv4Network(),
-			"infoblox_ipv6_network":           dataSourceIPv6Network(),
-			"infoblox_ipv4_network_container": dataSourceIpv4NetworkContainer(),
-			"infoblox_ipv6_network_container": dataSourceIpv6NetworkContainer(),
-			"infoblox_network_view":           dataSourceNetworkView(),
-			"infoblox_a_record":               dataSourceARecord(),
-			"infoblox_aaaa_record":            dataSourceAAAARecord(),
-			"infoblox_cname_record":           dataSourceCNameRecord(),
-			"infoblox_ptr_record":             dataSourcePtrRecord(),
-			"infoblox_zone_delegated":         dataSourceZoneDelegated(),
-			"infoblox_txt_record":             dataSourceTXTRecord(),
-			"infoblox_mx_record":              dataSourceMXRecord(),
-			"infoblox_srv_record":             dataSourceSRVRecord(),
-			"infoblox_host_record":            dataSourceHostRecord(),
-			"infoblox_zone_auth":              dataSourceZoneAuth(),
-			"infoblox_dns_view":               dataSourceDNSView(),
-			"infoblox_zone_forward":           dataSourceZoneForward(),
-			"infoblox_dtc_lbdn":               dataSourceDtcLbdnRecord(),
-			"infoblox_dtc_pool":               datasourceDtcPool(),
-			"infoblox_dtc_server":             dataSourceDtcServer(),
+			"infoblox_ipv4_network":             dataSourceIPv4Network(),
+			"infoblox_ipv6_network":             dataSourceIPv6Network(),
+			"infoblox_ipv4_network_container":   dataSourceIpv4NetworkContainer(),
+			"infoblox_ipv6_network_container":   dataSourceIpv6NetworkContainer(),
+			"infoblox_network_view":             dataSourceNetworkView(),
+			"infoblox_a_record":                 dataSourceARecord(),
+			"infoblox_aaaa_record":              dataSourceAAAARecord(),
+			"infoblox_cname_record":             dataSourceCNameRecord(),
+			"infoblox_ptr_record":               dataSourcePtrRecord(),
+			"infoblox_zone_delegated":           dataSourceZoneDelegated(),
+			"infoblox_txt_record":               dataSourceTXTRecord(),
+			"infoblox_mx_record":                dataSourceMXRecord(),
+			"infoblox_srv_record":               dataSourceSRVRecord(),
+			"infoblox_host_record":              dataSourceHostRecord(),
+			"infoblox_zone_auth":                dataSourceZoneAuth(),
+			"infoblox_dns_view":                 dataSourceDNSView(),
+			"infoblox_zone_forward":             dataSourceZoneForward(),
+			"infoblox_dtc_lbdn":                 dataSourceDtcLbdnRecord(),
+			"infoblox_dtc_pool":                 datasourceDtcPool(),
+			"infoblox_dtc_server":               dataSourceDtcServer(),
+			"infoblox_zone_stub":                dataSourceZoneStub(),
+			"infoblox_zone_file":                dataSourceZoneFile(),
+			"infoblox_dns_records":              dataSourceDNSRecords(),
+			"infoblox_ipv4_address":             dataSourceIPv4Address(),
+			"infoblox_ipv6_address":             dataSourceIPv6Address(),
+			"infoblox_next_available_network":   dataSourceNextAvailableNetwork(),
+			"infoblox_next_available_ip":        dataSourceNextAvailableIP(),
+			"infoblox_ipv4_network_utilization": dataSourceIPv4NetworkUtilization(),
+			"infoblox_ipv4_range_utilization":   dataSourceIPv4RangeUtilization(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -270,21 +326,27 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		HttpPoolConnections: d.Get("pool_connections").(int),
 	}
 
-	requestBuilder := &ibclient.WapiRequestBuilder{}
-	requestor := &ibclient.WapiHttpRequestor{}
+	requestBuilder := &wapiRequestBuilder{}
+	requestor := &wapiHttpRequestor{}
 
-	// TODO: reconsider. For the case when there is a need to keep more data than just a go-client's Connector.
 	conn, err := ibclient.NewConnector(hostConfig, authConfig, transportConfig, requestBuilder, requestor)
 	if err != nil {
 		return nil, diag.Diagnostics{diag.Diagnostic{Summary: err.Error()}}
 	}
 
+	meta := &providerConnector{IBConnector: conn}
+	if d.Get("allocation_lock").(bool) {
+		meta.allocationLock = newAllocationLock(
+			time.Duration(d.Get("allocation_lock_timeout").(int))*time.Second,
+			time.Duration(d.Get("allocation_lock_stale_after").(int))*time.Second)
+	}
+
 	// Check and Create Pre-requisites
-	err = checkAndCreatePreRequisites(conn)
+	err = checkAndCreatePreRequisites(conn, meta.allocationLock != nil)
 	if err != nil {
 		return nil, diag.Diagnostics{diag.Diagnostic{Summary: err.Error()}}
 	}
-	return conn, nil
+	return meta, nil
 }
 
 // filterFromMap generates filter map for NIOS query parameters from a terraform map[string]interface{}
@@ -392,8 +454,8 @@ func checkEARequirement(name string, conn ibclient.IBConnector) bool {
 	var res []ibclient.EADefinition
 
 	err := conn.GetObject(eadef, "", qp, &res)
-	if err != nil {
-		fmt.Errorf("failed to get EA definition")
+	if err != nil || len(res) == 0 {
+		return false
 	}
 	result := &res[0]
 	if result.Flags != nil {
@@ -405,7 +467,7 @@ func checkEARequirement(name string, conn ibclient.IBConnector) bool {
 }
 
 // Check Pre-requisites for the provider and create if not present
-func checkAndCreatePreRequisites(conn ibclient.IBConnector) error {
+func checkAndCreatePreRequisites(conn ibclient.IBConnector, allocationLock bool) error {
 	// 1. Create EA Definition for Internal ID if not present.
 
 	objMgr := ibclient.NewObjectManager(conn, "Terraform", "")
@@ -428,6 +490,34 @@ func checkAndCreatePreRequisites(conn ibclient.IBConnector) error {
 			return err
 		}
 	}
+
+	// 2. Create EA Definitions for the allocation lock if it is enabled and they are not present.
+	if allocationLock {
+		lockEAs := []struct {
+			name    string
+			eaType  string
+			comment string
+		}{
+			{eaNameForAllocationLock, "STRING", "Owner of the allocation lock on the network view held by Terraform"},
+			{eaNameForAllocationLockTime, "INTEGER", "Time when the allocation lock on the network view was taken by Terraform"},
+		}
+		for _, lockEA := range lockEAs {
+			_, err = objMgr.GetEADefinition(lockEA.name)
+			if !isNotFoundError(err) {
+				continue
+			}
+			name, comment := lockEA.name, lockEA.comment
+			_, err = objMgr.CreateEADefinition(ibclient.EADefinition{
+				Name:    &name,
+				Type:    lockEA.eaType,
+				Comment: &comment,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -473,6 +563,95 @@ func searchObjectByRefOrInternalId(objType string, d *schema.ResourceData, m int
 	return objMgr.SearchObjectByAltId(objType, ref, actualIntId.String(), eaNameForInternalId)
 }
 
+// searchGenericObjectByRefOrInternalId does the same as searchObjectByRefOrInternalId
+// but for NIOS object types which go-client's SearchObjectByAltId is not aware of.
+// 'obj' defines the WAPI object type and the return fields to be requested
+// (they must include 'extattrs'), 'res' must be a pointer to the structure
+// the object is to be unmarshalled to.
+func searchGenericObjectByRefOrInternalId(
+	obj ibclient.IBObject, d *schema.ResourceData, m interface{}, res interface{}) error {
+
+	var (
+		ref        string
+		internalId string
+	)
+
+	if r, found := d.GetOk("ref"); found {
+		ref = r.(string)
+	} else {
+		_, ref = getAltIdFields(d.Id())
+	}
+
+	if id, found := d.GetOk("internal_id"); found {
+		if newInternalResourceIdFromString(id.(string)) == nil {
+			return fmt.Errorf("internal_id value is not in a proper format")
+		}
+		internalId = id.(string)
+	}
+
+	connector := m.(ibclient.IBConnector)
+
+	if ref != "" {
+		var rec map[string]interface{}
+		err := connector.GetObject(obj, ref, ibclient.NewQueryParams(false, nil), &rec)
+		if err == nil && rec != nil {
+			if internalId == "" || getInternalIdFromWapiObject(rec) == internalId {
+				return convertWapiObject(rec, res)
+			}
+		} else if internalId == "" {
+			if err == nil {
+				err = ibclient.NewNotFoundError("requested object not found")
+			}
+			return err
+		}
+	}
+
+	if internalId == "" {
+		return ibclient.NewNotFoundError("neither a reference nor an internal ID is defined for the object")
+	}
+
+	var recs []map[string]interface{}
+	sf := map[string]string{
+		fmt.Sprintf("*%s", eaNameForInternalId): internalId,
+	}
+	err := connector.GetObject(obj, "", ibclient.NewQueryParams(false, sf), &recs)
+	if err != nil {
+		return err
+	}
+	if len(recs) == 0 {
+		return ibclient.NewNotFoundError("record not found")
+	}
+
+	return convertWapiObject(recs[0], res)
+}
+
+// getInternalIdFromWapiObject returns the value of 'Terraform Internal ID' EA
+// of a WAPI object represented as a generic JSON map, an empty string otherwise.
+func getInternalIdFromWapiObject(rec map[string]interface{}) string {
+	eas, ok := rec["extattrs"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	ea, ok := eas[eaNameForInternalId].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	id, _ := ea["value"].(string)
+
+	return id
+}
+
+// convertWapiObject converts a WAPI object, represented in any JSON-compatible form,
+// to the structure pointed by 'res'.
+func convertWapiObject(rec interface{}, res interface{}) error {
+	recJson, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(recJson, res)
+}
+
 func CompareSortedList(oldList interface{}, newList interface{}, key1 string, key2 string) bool {
 	oldListSlice, okOld := oldList.([]interface{})
 	newListSlice, okNew := newList.([]interface{})
@@ -0,0 +1,204 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// rangeStartSchema, rangeEndSchema, rangeFilterParamsSchema and excludeSchema are the fields of the resources
+// which may allocate the next available IP address from a DHCP range instead of a network.
+var (
+	rangeStartSchema = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		RequiredWith:  []string{"range_end"},
+		ConflictsWith: []string{"range_filter_params"},
+		Description: "The start address of the DHCP range to allocate the next available IP address from, " +
+			"along with 'range_end' (dynamic allocation). For static allocation, leave this field empty.",
+	}
+	rangeEndSchema = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		RequiredWith:  []string{"range_start"},
+		ConflictsWith: []string{"range_filter_params"},
+		Description: "The end address of the DHCP range to allocate the next available IP address from, " +
+			"along with 'range_start' (dynamic allocation). For static allocation, leave this field empty.",
+	}
+	rangeFilterParamsSchema = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"range_start", "range_end"},
+		Description: "The extensible attributes of the DHCP range to allocate the next available IP address from, " +
+			"as a map in JSON format, the same way as 'filter_params' (dynamic allocation). " +
+			"For static allocation, leave this field empty.",
+	}
+	excludeSchema = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Description: "The IP addresses which must not be allocated from the DHCP range, " +
+			"in addition to the exclusion ranges of the DHCP range itself. Used only at the allocation.",
+	}
+)
+
+// recordPtrNextAvailable is a PTR record which takes the next available IP address of a DHCP range,
+// as the go-client's RecordPTR holds IP addresses only as strings.
+type recordPtrNextAvailable struct {
+	ibclient.IBBase `json:"-"`
+	PtrdName        string                        `json:"ptrdname"`
+	View            string                        `json:"view,omitempty"`
+	Ipv4Addr        *ibclient.IpNextAvailableInfo `json:"ipv4addr,omitempty"`
+	Ipv6Addr        *ibclient.IpNextAvailableInfo `json:"ipv6addr,omitempty"`
+	UseTtl          bool                          `json:"use_ttl"`
+	Ttl             uint32                        `json:"ttl"`
+	Comment         string                        `json:"comment"`
+	Ea              ibclient.EA                   `json:"extattrs"`
+}
+
+func (r *recordPtrNextAvailable) ObjectType() string {
+	return "record:ptr"
+}
+
+// rangeAllocation is the DHCP range to allocate the next available IP address from,
+// selected either by its start and end addresses or by its extensible attributes.
+type rangeAllocation struct {
+	start    string
+	end      string
+	eaFilter map[string]string
+	exclude  []string
+}
+
+// isRangeAllocation tells whether the resource allocates its IP address from a DHCP range.
+func isRangeAllocation(d *schema.ResourceData) bool {
+	return d.Get("range_start").(string) != "" || d.Get("range_filter_params").(string) != ""
+}
+
+// getRangeAllocation returns the DHCP range the resource allocates its IP address from, nil if there is none.
+func getRangeAllocation(d *schema.ResourceData) (*rangeAllocation, error) {
+	exclude := d.Get("exclude").([]interface{})
+	if !isRangeAllocation(d) {
+		if len(exclude) > 0 {
+			return nil, fmt.Errorf(
+				"'exclude' field may be used only along with 'range_start' and 'range_end' or 'range_filter_params' fields")
+		}
+		return nil, nil
+	}
+
+	r := &rangeAllocation{}
+	if start := d.Get("range_start").(string); start != "" {
+		startIP := net.ParseIP(start)
+		if startIP == nil {
+			return nil, fmt.Errorf("'%s' is not a valid IP address", start)
+		}
+		end := d.Get("range_end").(string)
+		endIP := net.ParseIP(end)
+		if endIP == nil {
+			return nil, fmt.Errorf("'%s' is not a valid IP address", end)
+		}
+		if (startIP.To4() == nil) != (endIP.To4() == nil) {
+			return nil, fmt.Errorf("the start and the end addresses of the DHCP range must be of the same IP version")
+		}
+		r.start, r.end = startIP.String(), endIP.String()
+	} else if err := json.Unmarshal([]byte(d.Get("range_filter_params").(string)), &r.eaFilter); err != nil {
+		return nil, fmt.Errorf("error unmarshalling 'range_filter_params': %w", err)
+	}
+
+	for _, ip := range exclude {
+		if net.ParseIP(ip.(string)) == nil {
+			return nil, fmt.Errorf("'%s' is not a valid IP address to exclude", ip)
+		}
+		r.exclude = append(r.exclude, ip.(string))
+	}
+
+	return r, nil
+}
+
+// isIPv6 tells whether the DHCP range is an IPv6 one; the IP version of the range
+// selected by its extensible attributes is looked up in the network view.
+func (r *rangeAllocation) isIPv6(connector ibclient.IBConnector, networkView string) (bool, error) {
+	if r.start != "" {
+		return net.ParseIP(r.start).To4() == nil, nil
+	}
+
+	for _, objType := range []string{"range", "ipv6range"} {
+		var ranges []map[string]interface{}
+		err := connector.GetObject(
+			newWapiObject(objType, []string{"start_addr"}), "",
+			ibclient.NewQueryParams(false, r.objectParams(networkView)), &ranges)
+		if err != nil && !isNotFoundError(err) {
+			return false, fmt.Errorf("failed to search for the DHCP ranges of network view '%s': %w", networkView, err)
+		}
+		if len(ranges) > 0 {
+			return objType == "ipv6range", nil
+		}
+	}
+
+	return false, fmt.Errorf("no DHCP range of network view '%s' matches 'range_filter_params'", networkView)
+}
+
+// objectParams returns the search fields which select the DHCP range in the network view.
+func (r *rangeAllocation) objectParams(networkView string) map[string]string {
+	params := map[string]string{"network_view": networkView}
+	if r.start != "" {
+		params["start_addr"] = r.start
+		params["end_addr"] = r.end
+		return params
+	}
+	for k, v := range r.eaFilter {
+		params[k] = v
+	}
+
+	return params
+}
+
+// setNextAvailableIp makes the go-client's next available IP address function take the address
+// from the DHCP range instead of a network, skipping the excluded addresses.
+func (r *rangeAllocation) setNextAvailableIp(info *ibclient.IpNextAvailableInfo, isIPv6 bool, networkView string) {
+	info.Object = "range"
+	if isIPv6 {
+		info.Object = "ipv6range"
+	}
+	info.ObjectParams = r.objectParams(networkView)
+	info.Params = nil
+	if len(r.exclude) > 0 {
+		info.Params = map[string][]string{"exclude": r.exclude}
+	}
+}
+
+// allocateInRange creates the object which takes the next available IP address of a DHCP range,
+// holding the allocation lock on the network view; the reference of the object is returned.
+func allocateInRange(m interface{}, networkView string, obj ibclient.IBObject) (string, error) {
+	var ref string
+	err := withAllocationLock(m, networkView, func() (err error) {
+		ref, err = m.(ibclient.IBConnector).CreateObject(obj)
+		return
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to allocate the next available IP address of the DHCP range: %w", err)
+	}
+
+	return ref, nil
+}
+
+// checkRangeAllocationUpdate checks that the DHCP range the resource allocated its IP address from is not changed.
+func checkRangeAllocationUpdate(d *schema.ResourceData) error {
+	for _, field := range []string{"range_start", "range_end", "range_filter_params"} {
+		if d.HasChange(field) {
+			return fmt.Errorf("changing the value of '%s' field is not allowed", field)
+		}
+	}
+
+	return nil
+}
+
+// revertRangeAllocationFields sets the fields of the DHCP range back to their previous values, after a failed update.
+func revertRangeAllocationFields(d *schema.ResourceData) {
+	for _, field := range []string{"range_start", "range_end", "range_filter_params", "exclude"} {
+		prev, _ := d.GetChange(field)
+		_ = d.Set(field, prev)
+	}
+}
@@ -0,0 +1,173 @@
+package infoblox
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// createPtrSchema and ptrRefSchema are the fields of A and AAAA records' resources
+// which manage the matching PTR records.
+var (
+	createPtrSchema = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "Determines whether the PTR record which matches the record is created in the reverse zone " +
+			"of the IP address and kept in sync with the record; the reverse zone must exist.",
+	}
+	ptrRefSchema = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "NIOS object's reference of the PTR record created for the record, if any.",
+	}
+)
+
+// recordPtrCustomizeDiff makes the PTR record be re-created if it has been deleted outside of Terraform.
+func recordPtrCustomizeDiff(d *schema.ResourceDiff) error {
+	if d.Id() != "" && d.Get("create_ptr").(bool) && d.Get("ptr_ref").(string) == "" {
+		return d.SetNewComputed("ptr_ref")
+	}
+	return nil
+}
+
+// getReverseZone returns the authoritative reverse zone of the DNS view which contains the IP address;
+// of several zones the most specific one is returned.
+func getReverseZone(connector ibclient.IBConnector, dnsView string, ipAddr string) (string, error) {
+	ip := net.ParseIP(ipAddr)
+	if ip == nil {
+		return "", fmt.Errorf("'%s' is not a valid IP address", ipAddr)
+	}
+
+	zoneFormat := "IPV6"
+	if ip.To4() != nil {
+		zoneFormat = "IPV4"
+	}
+
+	var zones []ibclient.ZoneAuth
+	sf := map[string]string{
+		"view":        dnsView,
+		"zone_format": zoneFormat,
+	}
+	err := connector.GetObject(&ibclient.ZoneAuth{}, "", ibclient.NewQueryParams(false, sf), &zones)
+	if err != nil && !isNotFoundError(err) {
+		return "", fmt.Errorf("failed to get the reverse zones of DNS view '%s': %w", dnsView, err)
+	}
+
+	zone := ""
+	zoneOnes := -1
+	for _, z := range zones {
+		_, ipNet, err := net.ParseCIDR(z.Fqdn)
+		if err != nil || !ipNet.Contains(ip) {
+			continue
+		}
+		if ones, _ := ipNet.Mask.Size(); ones > zoneOnes {
+			zone, zoneOnes = z.Fqdn, ones
+		}
+	}
+	if zone == "" {
+		return "", fmt.Errorf(
+			"there is no reverse zone for IP address '%s' in DNS view '%s', "+
+				"the zone must exist to create a PTR record ('create_ptr' is true)", ipAddr, dnsView)
+	}
+
+	return zone, nil
+}
+
+// syncRecordPtr creates, updates or deletes the PTR record of an A or AAAA record according to 'create_ptr' field,
+// to match the record's FQDN, IP address, TTL, comment and extensible attributes; 'ptr_ref' is set accordingly.
+// 'ipField' is the name of the field of the record's IP address.
+func syncRecordPtr(
+	d *schema.ResourceData, m interface{}, ipField string, ttl uint32, useTtl bool, extAttrs map[string]interface{}) error {
+
+	ipAddr := d.Get(ipField).(string)
+	createPtr := d.Get("create_ptr").(bool)
+	ptrRef := d.Get("ptr_ref").(string)
+	dnsView := d.Get("dns_view").(string)
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
+	comment := d.Get("comment").(string)
+
+	eas := make(ibclient.EA, len(extAttrs))
+	for k, v := range extAttrs {
+		if k != eaNameForInternalId {
+			eas[k] = v
+		}
+	}
+
+	var tenantID string
+	if tempVal, found := eas[eaNameForTenantId]; found {
+		tenantID = tempVal.(string)
+	}
+	objMgr := ibclient.NewObjectManager(m.(ibclient.IBConnector), "Terraform", tenantID)
+
+	// The PTR record is re-created if the IP address changes, as the new address may belong to another reverse zone.
+	if ptrRef != "" && (!createPtr || d.HasChange(ipField)) {
+		if _, err := objMgr.DeletePTRRecord(ptrRef); err != nil && !isNotFoundError(err) {
+			return fmt.Errorf("deletion of PTR record failed: %w", err)
+		}
+		ptrRef = ""
+		if err := d.Set("ptr_ref", ""); err != nil {
+			return err
+		}
+	}
+	if !createPtr {
+		return nil
+	}
+
+	if ptrRef == "" {
+		if _, err := getReverseZone(m.(ibclient.IBConnector), dnsView, ipAddr); err != nil {
+			return err
+		}
+		ptr, err := objMgr.CreatePTRRecord("", dnsView, fqdn, "", "", ipAddr, useTtl, ttl, comment, eas)
+		if err != nil {
+			return fmt.Errorf("creation of PTR record for IP address '%s' failed: %w", ipAddr, err)
+		}
+		return d.Set("ptr_ref", ptr.Ref)
+	}
+
+	if !d.HasChanges("fqdn", "ttl", "comment", "ext_attrs") {
+		return nil
+	}
+	ptr, err := objMgr.UpdatePTRRecord(ptrRef, "", fqdn, "", "", ipAddr, useTtl, ttl, comment, eas)
+	if err != nil {
+		return fmt.Errorf("error updating PTR record: %w", err)
+	}
+
+	return d.Set("ptr_ref", ptr.Ref)
+}
+
+// readRecordPtr checks that the PTR record of an A or AAAA record still exists,
+// 'ptr_ref' is cleared otherwise to have it re-created.
+func readRecordPtr(d *schema.ResourceData, m interface{}) error {
+	ptrRef := d.Get("ptr_ref").(string)
+	if ptrRef == "" {
+		return nil
+	}
+
+	objMgr := ibclient.NewObjectManager(m.(ibclient.IBConnector), "Terraform", "")
+	if _, err := objMgr.GetPTRRecordByRef(ptrRef); err != nil {
+		if !isNotFoundError(err) {
+			return fmt.Errorf("failed getting PTR record: %w", err)
+		}
+		return d.Set("ptr_ref", "")
+	}
+
+	return nil
+}
+
+// deleteRecordPtr deletes the PTR record of an A or AAAA record, if any.
+func deleteRecordPtr(d *schema.ResourceData, m interface{}) error {
+	ptrRef := d.Get("ptr_ref").(string)
+	if ptrRef == "" {
+		return nil
+	}
+
+	objMgr := ibclient.NewObjectManager(m.(ibclient.IBConnector), "Terraform", "")
+	if _, err := objMgr.DeletePTRRecord(ptrRef); err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("deletion of PTR record failed: %w", err)
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
@@ -24,7 +26,10 @@ func resourceARecord() *schema.Resource {
 					return err
 				}
 			}
-			return nil
+			if err := dnsNameFormsCustomizeDiff(d, "fqdn"); err != nil {
+				return err
+			}
+			return recordPtrCustomizeDiff(d)
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,16 +40,19 @@ func resourceARecord() *schema.Resource {
 				Description: "DNS view which the zone does exist within.",
 			},
 			"fqdn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "FQDN for the A-record.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "FQDN for the A-record.",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
+			"fqdn_ascii":   dnsNameAsciiSchema("fqdn"),
+			"fqdn_unicode": dnsNameUnicodeSchema("fqdn"),
 			"ip_addr": {
 				Type:     schema.TypeString,
 				Computed: true,
 				Optional: true, // making this optional because of possible dynamic IP allocation (CIDR)
 				Description: "IP address to associate with the A-record. For static allocation, set the field with a valid IP address. For dynamic allocation, leave this field empty and set 'cidr' and 'network_view' fields" +
-					"or 'filter_params' and optional 'network_view' fields.",
+					"or 'filter_params' and optional 'network_view' fields, or 'range_start' and 'range_end' or 'range_filter_params' fields to allocate it from a DHCP range.",
 			},
 			"network_view": {
 				Type:        schema.TypeString,
@@ -62,6 +70,10 @@ func resourceARecord() *schema.Resource {
 				Optional:    true,
 				Description: "Network to allocate an IP address from, when the 'ip_addr' field is empty (dynamic allocation). The address is in CIDR format. For static allocation, leave this field empty.",
 			},
+			"range_start":         rangeStartSchema,
+			"range_end":           rangeEndSchema,
+			"range_filter_params": rangeFilterParamsSchema,
+			"exclude":             excludeSchema,
 			"ttl": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -80,6 +92,8 @@ func resourceARecord() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the A-record to be added/updated, as a map in JSON format",
 			},
+			"create_ptr": createPtrSchema,
+			"ptr_ref":    ptrRefSchema,
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -108,16 +122,24 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 	}
 	cidr := d.Get("cidr").(string)
 	dnsViewName := d.Get("dns_view").(string)
-	fqdn := d.Get("fqdn").(string)
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
 	ipAddr := d.Get("ip_addr").(string)
 	nextAvailableFilter := d.Get("filter_params").(string)
-	if ipAddr == "" && cidr == "" && nextAvailableFilter == "" {
+	rng, err := getRangeAllocation(d)
+	if err != nil {
+		return err
+	}
+	if ipAddr == "" && cidr == "" && nextAvailableFilter == "" && rng == nil {
 		return fmt.Errorf("either of 'ip_addr' or 'cidr' or 'filter_params' values is required")
 	}
 
 	if ipAddr != "" && cidr != "" && nextAvailableFilter == "" {
 		return fmt.Errorf("only one of 'ip_addr' or 'cidr' or 'filter_params' values is allowed to be defined")
 	}
+	if rng != nil && (ipAddr != "" || cidr != "" || nextAvailableFilter != "") {
+		return fmt.Errorf(
+			"the DHCP range to allocate the IP address from is not allowed to be defined along with 'ip_addr', 'cidr' or 'filter_params'")
+	}
 
 	var ttl uint32
 	useTtl := false
@@ -150,18 +172,42 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
+	// The reverse zone is checked in advance, not to create the record which cannot have its PTR record.
+	if d.Get("create_ptr").(bool) && ipAddr != "" {
+		if _, err = getReverseZone(connector, dnsViewName, ipAddr); err != nil {
+			return err
+		}
+	}
+
 	var newRecord *ibclient.RecordA
-	if cidr == "" && ipAddr == "" && nextAvailableFilter != "" {
+	if rng != nil {
+		if rng.start != "" && net.ParseIP(rng.start).To4() == nil {
+			return fmt.Errorf("an IPv4 DHCP range is expected, got '%s'-'%s'", rng.start, rng.end)
+		}
+		rec := ibclient.NewIpNextAvailable(fqdn, "record:a", nil, nil, false, extAttrs, comment, false, nil, "IPV4",
+			false, false, "", "", networkView, dnsViewName, useTtl, ttl, nil)
+		rng.setNextAvailableIp(rec.NextAvailableIPv4Addr, false, networkView)
+		ref, err := allocateInRange(m, networkView, rec)
+		if err != nil {
+			return err
+		}
+		if newRecord, err = objMgr.GetARecordByRef(ref); err != nil {
+			return fmt.Errorf("failed to get the A-record allocated from the DHCP range: %w", err)
+		}
+	} else if cidr == "" && ipAddr == "" && nextAvailableFilter != "" {
 		var (
 			eaMap map[string]string
 		)
-		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
-		eaMap["network_view"] = networkView
+		eaMap, err = parseNextAvailableFilter(nextAvailableFilter, networkView)
 		if err != nil {
 			return fmt.Errorf("error unmarshalling extra attributes of network container: %s", err)
 		}
-		rec, err := objMgr.AllocateNextAvailableIp(fqdn, "record:a", eaMap, nil, false, extAttrs, comment, false, nil, "IPV4",
-			false, false, "", "", networkView, dnsViewName, useTtl, ttl, nil)
+		var rec interface{}
+		err = withAllocationLock(m, networkView, func() (err error) {
+			rec, err = objMgr.AllocateNextAvailableIp(fqdn, "record:a", eaMap, nil, false, extAttrs, comment, false, nil, "IPV4",
+				false, false, "", "", networkView, dnsViewName, useTtl, ttl, nil)
+			return
+		})
 		if err != nil {
 			return fmt.Errorf("error allocating next available IP: %w", err)
 		}
@@ -171,16 +217,25 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 			return fmt.Errorf("failed to convert rec to *ibclient.RecordA")
 		}
 	} else {
-		newRecord, err = objMgr.CreateARecord(
-			networkView,
-			dnsViewName,
-			fqdn,
-			cidr,
-			ipAddr,
-			ttl,
-			useTtl,
-			comment,
-			extAttrs)
+		create := func() (err error) {
+			newRecord, err = objMgr.CreateARecord(
+				networkView,
+				dnsViewName,
+				fqdn,
+				cidr,
+				ipAddr,
+				ttl,
+				useTtl,
+				comment,
+				extAttrs)
+			return
+		}
+		// The next available IP address of the network is allocated if the network is specified.
+		if cidr != "" {
+			err = withAllocationLock(m, networkView, create)
+		} else {
+			err = create()
+		}
 		if err != nil {
 			return fmt.Errorf("creation of A-record under DNS view '%s' failed: %w", dnsViewName, err)
 		}
@@ -207,6 +262,15 @@ func resourceARecordCreate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	if err = syncRecordPtr(d, m, "ip_addr", ttl, useTtl, extAttrs); err != nil {
+		// The record is deleted, not to leave it without its PTR record.
+		if _, delErr := objMgr.DeleteARecord(newRecord.Ref); delErr != nil {
+			return fmt.Errorf("%s; deletion of A-record failed: %w", err, delErr)
+		}
+		d.SetId("")
+		return err
+	}
+
 	return nil
 }
 
@@ -294,6 +358,14 @@ func resourceARecordGet(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err = readRecordPtr(d, m); err != nil {
+		return err
+	}
+
+	if err := setDnsNameForms(d, "fqdn"); err != nil {
+		return err
+	}
+
 	d.SetId(recA.Ref)
 
 	return nil
@@ -327,6 +399,7 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
 			_ = d.Set("filter_params", prevNextAvailableFilter.(string))
+			revertRangeAllocationFields(d)
 		}
 	}()
 
@@ -343,9 +416,12 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 	if d.HasChange("filter_params") {
 		return fmt.Errorf("changing the value of 'filter_params' field is not allowed")
 	}
+	if err := checkRangeAllocationUpdate(d); err != nil {
+		return err
+	}
 
 	networkView := d.Get("network_view").(string)
-	fqdn := d.Get("fqdn").(string)
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
 	cidr := d.Get("cidr").(string)
 	ipAddr := d.Get("ip_addr").(string)
 
@@ -451,6 +527,10 @@ func resourceARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err = syncRecordPtr(d, m, "ip_addr", ttl, useTtl, newExtAttrs); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -486,6 +566,10 @@ func resourceARecordDelete(d *schema.ResourceData, m interface{}) error {
 	recJson, _ := json.Marshal(rec)
 	err = json.Unmarshal(recJson, &recA)
 
+	if err = deleteRecordPtr(d, m); err != nil {
+		return err
+	}
+
 	_, err = objMgr.DeleteARecord(recA.Ref)
 	if err != nil {
 		return fmt.Errorf("deletion of A-record failed: %w", err)
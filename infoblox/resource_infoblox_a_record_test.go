@@ -714,3 +714,241 @@ func TestAcc_resourceARecord_ea_inheritance(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_resourceARecord_createPtr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckARecordDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "infoblox_zone_auth" "zone1" {
+					fqdn = "test.com"
+				}
+				resource "infoblox_a_record" "rec_ptr" {
+					fqdn = "sampleptr.test.com"
+					ip_addr = "10.17.0.2"
+					create_ptr = true
+					depends_on = [infoblox_zone_auth.zone1]
+				}`,
+				ExpectError: regexp.MustCompile("there is no reverse zone for IP address '10.17.0.2'"),
+			},
+			{
+				Config: `
+				resource "infoblox_zone_auth" "zone1" {
+					fqdn = "test.com"
+				}
+				resource "infoblox_zone_auth" "rzone1" {
+					fqdn = "10.17.0.0/24"
+					zone_format = "IPV4"
+				}
+				resource "infoblox_a_record" "rec_ptr" {
+					fqdn = "sampleptr.test.com"
+					ip_addr = "10.17.0.2"
+					ttl = 300
+					create_ptr = true
+					depends_on = [infoblox_zone_auth.zone1, infoblox_zone_auth.rzone1]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("infoblox_a_record.rec_ptr", "ptr_ref"),
+					testAccCheckRecordPtr("infoblox_a_record.rec_ptr", "sampleptr.test.com", "10.17.0.2"),
+				),
+			},
+			{
+				Config: `
+				resource "infoblox_zone_auth" "zone1" {
+					fqdn = "test.com"
+				}
+				resource "infoblox_zone_auth" "rzone1" {
+					fqdn = "10.17.0.0/24"
+					zone_format = "IPV4"
+				}
+				resource "infoblox_a_record" "rec_ptr" {
+					fqdn = "sampleptr2.test.com"
+					ip_addr = "10.17.0.3"
+					ttl = 300
+					create_ptr = true
+					depends_on = [infoblox_zone_auth.zone1, infoblox_zone_auth.rzone1]
+				}`,
+				Check: testAccCheckRecordPtr("infoblox_a_record.rec_ptr", "sampleptr2.test.com", "10.17.0.3"),
+			},
+			{
+				Config: `
+				resource "infoblox_zone_auth" "zone1" {
+					fqdn = "test.com"
+				}
+				resource "infoblox_zone_auth" "rzone1" {
+					fqdn = "10.17.0.0/24"
+					zone_format = "IPV4"
+				}
+				resource "infoblox_a_record" "rec_ptr" {
+					fqdn = "sampleptr2.test.com"
+					ip_addr = "10.17.0.3"
+					ttl = 300
+					depends_on = [infoblox_zone_auth.zone1, infoblox_zone_auth.rzone1]
+				}`,
+				Check: resource.TestCheckResourceAttr("infoblox_a_record.rec_ptr", "ptr_ref", ""),
+			},
+		},
+	})
+}
+
+// testAccCheckRecordPtr checks that the PTR-record created for an A or AAAA record matches it.
+func testAccCheckRecordPtr(resPath string, expectedFqdn string, expectedIpAddr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		ptrRef := res.Primary.Attributes["ptr_ref"]
+		if ptrRef == "" {
+			return fmt.Errorf("'ptr_ref' attribute is not set")
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		objMgr := ibclient.NewObjectManager(connector, "terraform_test", "test")
+		ptr, err := objMgr.GetPTRRecordByRef(ptrRef)
+		if err != nil {
+			return fmt.Errorf("failed getting PTR record: %w", err)
+		}
+		if ptr.PtrdName == nil || *ptr.PtrdName != expectedFqdn {
+			return fmt.Errorf("PTR record's ptrdname is expected to be '%s'", expectedFqdn)
+		}
+		ipAddr := ""
+		if ptr.Ipv4Addr != nil {
+			ipAddr = *ptr.Ipv4Addr
+		} else if ptr.Ipv6Addr != nil {
+			ipAddr = *ptr.Ipv6Addr
+		}
+		if ipAddr != expectedIpAddr {
+			return fmt.Errorf("PTR record's IP address is '%s', expected to be '%s'", ipAddr, expectedIpAddr)
+		}
+
+		return nil
+	}
+}
+
+func TestAcc_resourceARecord_idn(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckARecordDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "infoblox_zone_auth" "zone1" {
+					fqdn = "bücher-test.com"
+				}
+				resource "infoblox_a_record" "idn" {
+					fqdn = "Host1.Bücher-Test.com."
+					ip_addr = "10.18.0.2"
+					depends_on = [infoblox_zone_auth.zone1]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_auth.zone1", "fqdn_ascii", "xn--bcher-test-9db.com"),
+					resource.TestCheckResourceAttr("infoblox_a_record.idn", "fqdn_ascii", "host1.xn--bcher-test-9db.com"),
+					resource.TestCheckResourceAttr("infoblox_a_record.idn", "fqdn_unicode", "host1.bücher-test.com"),
+				),
+			},
+			// The names which differ only in case, the trailing dot or the form of the labels
+			// must not cause any changes.
+			{
+				Config: `
+				resource "infoblox_zone_auth" "zone1" {
+					fqdn = "xn--bcher-test-9db.com"
+				}
+				resource "infoblox_a_record" "idn" {
+					fqdn = "host1.xn--bcher-test-9db.com"
+					ip_addr = "10.18.0.2"
+					depends_on = [infoblox_zone_auth.zone1]
+				}`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// testAccCreateRange creates a DHCP range in the default network view, the provider has no resource for ranges.
+func testAccCreateRange(network string, startAddr string, endAddr string, ea ibclient.EA) {
+	conn := testAccProvider.Meta().(ibclient.IBConnector)
+	r := &ibclient.Range{
+		Network:     utils.StringPtr(network),
+		NetworkView: utils.StringPtr(defaultNetView),
+		StartAddr:   utils.StringPtr(startAddr),
+		EndAddr:     utils.StringPtr(endAddr),
+		Ea:          ea,
+	}
+	if _, err := conn.CreateObject(r); err != nil {
+		panic(err)
+	}
+}
+
+func TestAcc_resourceARecord_range(t *testing.T) {
+	netConfig := `
+		resource "infoblox_zone_auth" "zone1" {
+			fqdn = "test.com"
+		}
+		resource "infoblox_ipv4_network" "net1" {
+			cidr = "10.40.0.0/24"
+		}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckARecordDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: netConfig,
+			},
+			{
+				PreConfig: func() {
+					testAccCreateRange("10.40.0.0/24", "10.40.0.10", "10.40.0.20", ibclient.EA{"Site": "Servers"})
+				},
+				Config: netConfig + `
+				resource "infoblox_a_record" "rec1" {
+					fqdn = "range1.test.com"
+					range_start = "10.40.0.10"
+					range_end = "10.40.0.20"
+					exclude = ["10.40.0.10", "10.40.0.11"]
+					depends_on = [infoblox_zone_auth.zone1, infoblox_ipv4_network.net1]
+				}`,
+				Check: resource.TestCheckResourceAttr("infoblox_a_record.rec1", "ip_addr", "10.40.0.12"),
+			},
+			{
+				Config: netConfig + `
+				resource "infoblox_a_record" "rec1" {
+					fqdn = "range1.test.com"
+					range_start = "10.40.0.10"
+					range_end = "10.40.0.20"
+					exclude = ["10.40.0.10", "10.40.0.11"]
+					depends_on = [infoblox_zone_auth.zone1, infoblox_ipv4_network.net1]
+				}
+				resource "infoblox_a_record" "rec2" {
+					fqdn = "range2.test.com"
+					range_filter_params = jsonencode({
+						"*Site" = "Servers"
+					})
+					depends_on = [infoblox_zone_auth.zone1, infoblox_ipv4_network.net1]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_a_record.rec1", "ip_addr", "10.40.0.12"),
+					resource.TestCheckResourceAttr("infoblox_a_record.rec2", "ip_addr", "10.40.0.10"),
+				),
+			},
+			{
+				Config: netConfig + `
+				resource "infoblox_a_record" "rec1" {
+					fqdn = "range1.test.com"
+					range_start = "10.40.0.10"
+					range_end = "10.40.0.30"
+					depends_on = [infoblox_zone_auth.zone1, infoblox_ipv4_network.net1]
+				}`,
+				ExpectError: regexp.MustCompile("changing the value of 'range_end' field is not allowed"),
+			},
+		},
+	})
+}
@@ -24,7 +24,10 @@ func resourceAAAARecord() *schema.Resource {
 					return err
 				}
 			}
-			return nil
+			if err := dnsNameFormsCustomizeDiff(d, "fqdn"); err != nil {
+				return err
+			}
+			return recordPtrCustomizeDiff(d)
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,10 +38,13 @@ func resourceAAAARecord() *schema.Resource {
 				Description: "DNS view which the zone does exist within.",
 			},
 			"fqdn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "FQDN for the AAAA-record.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "FQDN for the AAAA-record.",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
+			"fqdn_ascii":   dnsNameAsciiSchema("fqdn"),
+			"fqdn_unicode": dnsNameUnicodeSchema("fqdn"),
 			"ipv6_addr": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -86,6 +92,8 @@ func resourceAAAARecord() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the AAAA-record to be added/updated, as a map in JSON format",
 			},
+			"create_ptr": createPtrSchema,
+			"ptr_ref":    ptrRefSchema,
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -114,7 +122,7 @@ func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
 	}
 	cidr := d.Get("cidr").(string)
 	dnsViewName := d.Get("dns_view").(string)
-	fqdn := d.Get("fqdn").(string)
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
 	ipv6Addr := d.Get("ipv6_addr").(string)
 	nextAvailableFilter := d.Get("filter_params").(string)
 	if ipv6Addr == "" && cidr == "" && nextAvailableFilter == "" {
@@ -156,21 +164,39 @@ func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
+	// The reverse zone is checked in advance, not to create the record which cannot have its PTR record.
+	if d.Get("create_ptr").(bool) && ipv6Addr != "" {
+		if _, err = getReverseZone(connector, dnsViewName, ipv6Addr); err != nil {
+			return err
+		}
+	}
+
 	var (
 		newRecordAAAA interface{}
 		eaMap         map[string]string
 	)
 
 	if cidr == "" && ipv6Addr == "" && nextAvailableFilter != "" {
-		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
-		eaMap["network_view"] = networkView
+		eaMap, err = parseNextAvailableFilter(nextAvailableFilter, networkView)
 		if err != nil {
 			return fmt.Errorf("error unmarshalling extra attributes of network: %s", err)
 		}
-		newRecordAAAA, err = objMgr.AllocateNextAvailableIp(fqdn, "record:aaaa", eaMap, nil, false, extAttrs, comment, false, nil, "IPV6",
-			false, false, "", "", networkView, dnsViewName, false, ttl, nil)
+		err = withAllocationLock(m, networkView, func() (err error) {
+			newRecordAAAA, err = objMgr.AllocateNextAvailableIp(fqdn, "record:aaaa", eaMap, nil, false, extAttrs, comment, false, nil, "IPV6",
+				false, false, "", "", networkView, dnsViewName, false, ttl, nil)
+			return
+		})
 	} else {
-		newRecordAAAA, err = objMgr.CreateAAAARecord(networkView, dnsViewName, fqdn, cidr, ipv6Addr, useTtl, ttl, comment, extAttrs)
+		create := func() (err error) {
+			newRecordAAAA, err = objMgr.CreateAAAARecord(networkView, dnsViewName, fqdn, cidr, ipv6Addr, useTtl, ttl, comment, extAttrs)
+			return
+		}
+		// The next available IP address of the network is allocated if the network is specified.
+		if cidr != "" {
+			err = withAllocationLock(m, networkView, create)
+		} else {
+			err = create()
+		}
 	}
 	if err != nil {
 		return fmt.Errorf("creation of AAAA-record under DNS view '%s' failed: %w", dnsViewName, err)
@@ -201,6 +227,15 @@ func resourceAAAARecordCreate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	if err = syncRecordPtr(d, m, "ipv6_addr", ttl, useTtl, extAttrs); err != nil {
+		// The record is deleted, not to leave it without its PTR record.
+		if _, delErr := objMgr.DeleteAAAARecord(recordAAAA.Ref); delErr != nil {
+			return fmt.Errorf("%s; deletion of AAAA-record failed: %w", err, delErr)
+		}
+		d.SetId("")
+		return err
+	}
+
 	return nil
 }
 
@@ -293,6 +328,14 @@ func resourceAAAARecordGet(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err = readRecordPtr(d, m); err != nil {
+		return err
+	}
+
+	if err := setDnsNameForms(d, "fqdn"); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
@@ -345,7 +388,7 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	networkView := d.Get("network_view").(string)
-	fqdn := d.Get("fqdn").(string)
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
 	cidr := d.Get("cidr").(string)
 	ipv6Addr := d.Get("ipv6_addr").(string)
 
@@ -452,6 +495,10 @@ func resourceAAAARecordUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err = syncRecordPtr(d, m, "ipv6_addr", ttl, useTtl, newExtAttrs); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -492,6 +539,10 @@ func resourceAAAARecordDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("getting AAAA Record with ID: %s failed: %w", d.Id(), err)
 	}
 
+	if err = deleteRecordPtr(d, m); err != nil {
+		return err
+	}
+
 	_, err = objMgr.DeleteAAAARecord(obj.Ref)
 	if err != nil {
 		return fmt.Errorf("deletion of AAAA Record from dns view %s failed: %w", dnsView, err)
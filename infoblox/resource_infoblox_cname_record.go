@@ -25,6 +25,9 @@ func resourceCNAMERecord() *schema.Resource {
 					return err
 				}
 			}
+			if err := dnsNameFormsCustomizeDiff(d, "alias"); err != nil {
+				return err
+			}
 			return nil
 		},
 
@@ -36,15 +39,19 @@ func resourceCNAMERecord() *schema.Resource {
 				Description: "Dns View under which the zone has been created.",
 			},
 			"canonical": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The Canonical name in FQDN format.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The Canonical name in FQDN format.",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
 			"alias": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The alias name in FQDN format.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The alias name in FQDN format.",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
+			"alias_ascii":   dnsNameAsciiSchema("alias"),
+			"alias_unicode": dnsNameUnicodeSchema("alias"),
 			"ttl": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -85,8 +92,8 @@ func resourceCNAMERecordCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
 	}
 	dnsView := d.Get("dns_view").(string)
-	canonical := d.Get("canonical").(string)
-	alias := d.Get("alias").(string)
+	canonical := dnsNameForWapi(d.Get("canonical").(string))
+	alias := dnsNameForWapi(d.Get("alias").(string))
 
 	comment := d.Get("comment").(string)
 	extAttrJSON := d.Get("ext_attrs").(string)
@@ -200,6 +207,10 @@ func resourceCNAMERecordGet(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := setDnsNameForms(d, "alias"); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
@@ -236,8 +247,8 @@ func resourceCNAMERecordUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	dnsView := d.Get("dns_view").(string)
-	canonical := d.Get("canonical").(string)
-	alias := d.Get("alias").(string)
+	canonical := dnsNameForWapi(d.Get("canonical").(string))
+	alias := dnsNameForWapi(d.Get("alias").(string))
 	comment := d.Get("comment").(string)
 
 	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
@@ -2,10 +2,10 @@ package infoblox
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"github.com/infobloxopen/infoblox-go-client/v2/utils"
 	"regexp"
@@ -15,6 +15,61 @@ var (
 	dnsViewRegExp = regexp.MustCompile("^view/.+")
 )
 
+var dnsViewReturnFields = []string{
+	"name",
+	"comment",
+	"network_view",
+	"extattrs",
+	"match_clients",
+	"match_destinations",
+	"recursion",
+	"use_recursion",
+	"forwarders",
+	"forward_only",
+	"use_forwarders",
+	"lame_ttl",
+	"use_lame_ttl",
+	"enable_blacklist",
+	"blacklist_rulesets",
+	"blacklist_action",
+	"blacklist_redirect_addresses",
+	"blacklist_redirect_ttl",
+	"blacklist_log_query",
+	"use_blacklist",
+	"nxdomain_redirect",
+	"nxdomain_rulesets",
+	"nxdomain_redirect_addresses",
+	"nxdomain_redirect_addresses_v6",
+	"nxdomain_redirect_ttl",
+	"nxdomain_log_query",
+	"use_nxdomain_redirect",
+	"dns64_enabled",
+	"dns64_groups",
+	"use_dns64",
+}
+
+func newEmptyDNSView() *ibclient.View {
+	v := &ibclient.View{}
+	v.SetReturnFields(dnsViewReturnFields)
+
+	return v
+}
+
+// dnsView extends ibclient.View with the fields which NIOS must receive
+// even when they are empty lists, to be able to clear them.
+type dnsView struct {
+	ibclient.View
+	MatchClients                *aclList  `json:"match_clients,omitempty"`
+	MatchDestinations           *aclList  `json:"match_destinations,omitempty"`
+	Forwarders                  *[]string `json:"forwarders,omitempty"`
+	BlacklistRulesets           *[]string `json:"blacklist_rulesets,omitempty"`
+	BlacklistRedirectAddresses  *[]string `json:"blacklist_redirect_addresses,omitempty"`
+	NxdomainRulesets            *[]string `json:"nxdomain_rulesets,omitempty"`
+	NxdomainRedirectAddresses   *[]string `json:"nxdomain_redirect_addresses,omitempty"`
+	NxdomainRedirectAddressesV6 *[]string `json:"nxdomain_redirect_addresses_v6,omitempty"`
+	Dns64Groups                 *[]string `json:"dns64_groups,omitempty"`
+}
+
 func resourceDNSView() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDNSViewCreate,
@@ -54,6 +109,151 @@ func resourceDNSView() *schema.Resource {
 				Description: "The name of the Network View in which DNS View exists.",
 			},
 
+			"match_clients": aclSchema("The ordered list of clients whose queries are answered from the DNS view; " +
+				"the views of a network view are matched against a query in the order defined by 'order'."),
+
+			"match_destinations": aclSchema("The ordered list of destination addresses of the queries " +
+				"which are answered from the DNS view."),
+
+			"recursion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Determines whether recursive queries are allowed for the DNS view; inherited from the Grid if not set.",
+			},
+
+			"forwarders": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IP addresses of the servers the recursive queries of the DNS view are forwarded to.",
+			},
+
+			"forward_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether the queries are sent to the forwarders only, without an attempt to resolve them otherwise.",
+			},
+
+			"lame_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1800),
+				Description:  "The number of seconds to cache lame delegations; inherited from the Grid if not set.",
+			},
+
+			"blacklist": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The blacklist settings of the DNS view; the view inherits the settings if the block is not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rulesets": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names of the blacklist rulesets.",
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "REFUSE",
+							ValidateFunc: validation.StringInSlice([]string{"REDIRECT", "REFUSE"}, false),
+							Description:  "The action for the queries of blacklisted domain names: REDIRECT or REFUSE.",
+						},
+						"redirect_addresses": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IP addresses returned for the blacklisted domain names, if the action is REDIRECT.",
+						},
+						"redirect_ttl": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     60,
+							Description: "The TTL of the redirection responses, in seconds.",
+						},
+						"log_query": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Determines whether the queries of blacklisted domain names are logged.",
+						},
+					},
+				},
+			},
+
+			"nxdomain_redirect": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Description: "The NXDOMAIN redirection settings of the DNS view; " +
+					"the view inherits the settings if the block is not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rulesets": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names of the NXDOMAIN rulesets.",
+						},
+						"addresses": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IPv4 addresses returned instead of NXDOMAIN responses.",
+						},
+						"addresses_v6": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IPv6 addresses returned instead of NXDOMAIN responses.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     60,
+							Description: "The TTL of the redirection responses, in seconds.",
+						},
+						"log_query": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Determines whether the redirected queries are logged.",
+						},
+					},
+				},
+			},
+
+			"dns64": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The DNS64 settings of the DNS view; the view inherits the settings if the block is not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"groups": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names of the DNS64 synthesis groups.",
+						},
+					},
+				},
+			},
+
+			"order": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "The zero-based position of the DNS view among the views of its network view, " +
+					"which defines the order the views are matched against a query on the Grid members serving them.",
+			},
+
 			"ext_attrs": {
 				Type:        schema.TypeString,
 				Default:     "",
@@ -78,6 +278,402 @@ func resourceDNSView() *schema.Resource {
 	}
 }
 
+// dnsViewFieldIsSet determines whether the value of the field is to be sent to NIOS:
+// for Optional+Computed fields a zero value in the configuration is a value as well.
+func dnsViewFieldIsSet(create bool, d *schema.ResourceData, field string) bool {
+	if d.HasChange(field) {
+		return true
+	}
+	return create && !d.GetRawConfig().GetAttr(field).IsNull()
+}
+
+// convertStringListPtr converts a list of strings of a resource into a pointer to a (possibly empty) slice.
+func convertStringListPtr(list []interface{}) *[]string {
+	res := make([]string, 0, len(list))
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			res = append(res, s)
+		}
+	}
+	return &res
+}
+
+// formDNSView builds a WAPI object out of the resource's data;
+// the settings which are not changed are not sent to NIOS.
+func formDNSView(create bool, d *schema.ResourceData, m interface{}) (*dnsView, error) {
+	v := &dnsView{
+		View: ibclient.View{
+			Name: utils.StringPtr(d.Get("name").(string)),
+		},
+	}
+
+	if d.HasChange("comment") {
+		v.Comment = utils.StringPtr(d.Get("comment").(string))
+	}
+
+	if d.HasChange("network_view") {
+		v.NetworkView = utils.StringPtr(d.Get("network_view").(string))
+	}
+
+	connector := m.(ibclient.IBConnector)
+	acls := []struct {
+		field string
+		list  **aclList
+	}{
+		{"match_clients", &v.MatchClients},
+		{"match_destinations", &v.MatchDestinations},
+	}
+	for _, acl := range acls {
+		if !d.HasChange(acl.field) {
+			continue
+		}
+		entries, err := convertAclFromInterface(connector, d.Get(acl.field).([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of '%s': %w", acl.field, err)
+		}
+		list := aclList(entries)
+		*acl.list = &list
+	}
+
+	if dnsViewFieldIsSet(create, d, "recursion") {
+		v.Recursion = utils.BoolPtr(d.Get("recursion").(bool))
+		v.UseRecursion = utils.BoolPtr(true)
+	}
+
+	if d.HasChanges("forwarders", "forward_only") {
+		forwarders := convertStringListPtr(d.Get("forwarders").([]interface{}))
+		forwardOnly := d.Get("forward_only").(bool)
+		if forwardOnly && len(*forwarders) == 0 {
+			return nil, fmt.Errorf("'forward_only' requires at least one forwarder to be set")
+		}
+		v.Forwarders = forwarders
+		v.ForwardOnly = utils.BoolPtr(forwardOnly)
+		v.UseForwarders = utils.BoolPtr(len(*forwarders) > 0)
+	}
+
+	if dnsViewFieldIsSet(create, d, "lame_ttl") {
+		v.LameTtl = utils.Uint32Ptr(uint32(d.Get("lame_ttl").(int)))
+		v.UseLameTtl = utils.BoolPtr(true)
+	}
+
+	if d.HasChange("blacklist") {
+		blacklist := d.Get("blacklist").([]interface{})
+		v.UseBlacklist = utils.BoolPtr(len(blacklist) > 0)
+		if len(blacklist) > 0 && blacklist[0] != nil {
+			bl := blacklist[0].(map[string]interface{})
+			action := bl["action"].(string)
+			redirectAddresses := convertStringListPtr(bl["redirect_addresses"].([]interface{}))
+			if action == "REDIRECT" && len(*redirectAddresses) == 0 {
+				return nil, fmt.Errorf("the blacklist action 'REDIRECT' requires 'redirect_addresses' to be set")
+			}
+			v.EnableBlacklist = utils.BoolPtr(true)
+			v.BlacklistRulesets = convertStringListPtr(bl["rulesets"].([]interface{}))
+			v.BlacklistAction = action
+			v.BlacklistRedirectAddresses = redirectAddresses
+			v.BlacklistRedirectTtl = utils.Uint32Ptr(uint32(bl["redirect_ttl"].(int)))
+			v.BlacklistLogQuery = utils.BoolPtr(bl["log_query"].(bool))
+		}
+	}
+
+	if d.HasChange("nxdomain_redirect") {
+		nxdomain := d.Get("nxdomain_redirect").([]interface{})
+		v.UseNxdomainRedirect = utils.BoolPtr(len(nxdomain) > 0)
+		if len(nxdomain) > 0 && nxdomain[0] != nil {
+			nx := nxdomain[0].(map[string]interface{})
+			addresses := convertStringListPtr(nx["addresses"].([]interface{}))
+			addressesV6 := convertStringListPtr(nx["addresses_v6"].([]interface{}))
+			if len(*addresses) == 0 && len(*addressesV6) == 0 {
+				return nil, fmt.Errorf(
+					"at least one of 'addresses' and 'addresses_v6' must be set for NXDOMAIN redirection")
+			}
+			v.NxdomainRedirect = utils.BoolPtr(true)
+			v.NxdomainRulesets = convertStringListPtr(nx["rulesets"].([]interface{}))
+			v.NxdomainRedirectAddresses = addresses
+			v.NxdomainRedirectAddressesV6 = addressesV6
+			v.NxdomainRedirectTtl = utils.Uint32Ptr(uint32(nx["ttl"].(int)))
+			v.NxdomainLogQuery = utils.BoolPtr(nx["log_query"].(bool))
+		}
+	}
+
+	if d.HasChange("dns64") {
+		dns64 := d.Get("dns64").([]interface{})
+		v.UseDns64 = utils.BoolPtr(len(dns64) > 0)
+		if len(dns64) > 0 && dns64[0] != nil {
+			v.Dns64Enabled = utils.BoolPtr(true)
+			v.Dns64Groups = convertStringListPtr(dns64[0].(map[string]interface{})["groups"].([]interface{}))
+		}
+	}
+
+	return v, nil
+}
+
+// setDNSViewFields sets the resource's fields from the view read from NIOS;
+// the settings the view inherits are reported as empty.
+func setDNSViewFields(d *schema.ResourceData, v *dnsView) error {
+	if err := d.Set("name", v.Name); err != nil {
+		return err
+	}
+
+	if v.Comment != nil {
+		if err := d.Set("comment", v.Comment); err != nil {
+			return err
+		}
+	}
+
+	if v.NetworkView != nil {
+		if err := d.Set("network_view", v.NetworkView); err != nil {
+			return err
+		}
+	}
+
+	acls := []struct {
+		field string
+		list  *aclList
+	}{
+		{"match_clients", v.MatchClients},
+		{"match_destinations", v.MatchDestinations},
+	}
+	for _, acl := range acls {
+		var entries []interface{}
+		if acl.list != nil {
+			var err error
+			if entries, err = convertAclToInterface(*acl.list); err != nil {
+				return fmt.Errorf("failed to read '%s': %w", acl.field, err)
+			}
+		}
+		if err := d.Set(acl.field, entries); err != nil {
+			return err
+		}
+	}
+
+	if v.Recursion != nil {
+		if err := d.Set("recursion", *v.Recursion); err != nil {
+			return err
+		}
+	}
+
+	var forwarders []string
+	forwardOnly := false
+	if v.UseForwarders != nil && *v.UseForwarders {
+		if v.Forwarders != nil {
+			forwarders = *v.Forwarders
+		}
+		if v.ForwardOnly != nil {
+			forwardOnly = *v.ForwardOnly
+		}
+	}
+	if err := d.Set("forwarders", forwarders); err != nil {
+		return err
+	}
+	if err := d.Set("forward_only", forwardOnly); err != nil {
+		return err
+	}
+
+	if v.LameTtl != nil {
+		if err := d.Set("lame_ttl", int(*v.LameTtl)); err != nil {
+			return err
+		}
+	}
+
+	var blacklist []interface{}
+	if v.UseBlacklist != nil && *v.UseBlacklist && v.EnableBlacklist != nil && *v.EnableBlacklist {
+		bl := map[string]interface{}{
+			"rulesets":           derefStringList(v.BlacklistRulesets),
+			"action":             v.BlacklistAction,
+			"redirect_addresses": derefStringList(v.BlacklistRedirectAddresses),
+			"redirect_ttl":       0,
+			"log_query":          false,
+		}
+		if v.BlacklistRedirectTtl != nil {
+			bl["redirect_ttl"] = int(*v.BlacklistRedirectTtl)
+		}
+		if v.BlacklistLogQuery != nil {
+			bl["log_query"] = *v.BlacklistLogQuery
+		}
+		blacklist = append(blacklist, bl)
+	}
+	if err := d.Set("blacklist", blacklist); err != nil {
+		return err
+	}
+
+	var nxdomain []interface{}
+	if v.UseNxdomainRedirect != nil && *v.UseNxdomainRedirect && v.NxdomainRedirect != nil && *v.NxdomainRedirect {
+		nx := map[string]interface{}{
+			"rulesets":     derefStringList(v.NxdomainRulesets),
+			"addresses":    derefStringList(v.NxdomainRedirectAddresses),
+			"addresses_v6": derefStringList(v.NxdomainRedirectAddressesV6),
+			"ttl":          0,
+			"log_query":    false,
+		}
+		if v.NxdomainRedirectTtl != nil {
+			nx["ttl"] = int(*v.NxdomainRedirectTtl)
+		}
+		if v.NxdomainLogQuery != nil {
+			nx["log_query"] = *v.NxdomainLogQuery
+		}
+		nxdomain = append(nxdomain, nx)
+	}
+	if err := d.Set("nxdomain_redirect", nxdomain); err != nil {
+		return err
+	}
+
+	var dns64 []interface{}
+	if v.UseDns64 != nil && *v.UseDns64 && v.Dns64Enabled != nil && *v.Dns64Enabled {
+		dns64 = append(dns64, map[string]interface{}{
+			"groups": derefStringList(v.Dns64Groups),
+		})
+	}
+
+	return d.Set("dns64", dns64)
+}
+
+func derefStringList(list *[]string) []string {
+	if list == nil {
+		return nil
+	}
+	return *list
+}
+
+// getDNSViewNames returns the names of the DNS views of the network view.
+func getDNSViewNames(conn ibclient.IBConnector, networkView string) (map[string]bool, error) {
+	v := &ibclient.View{}
+	v.SetReturnFields([]string{"name"})
+
+	var views []ibclient.View
+	sf := map[string]string{
+		"network_view": networkView,
+	}
+	if err := conn.GetObject(v, "", ibclient.NewQueryParams(false, sf), &views); err != nil {
+		return nil, fmt.Errorf("failed to get DNS views of network view '%s': %w", networkView, err)
+	}
+
+	names := make(map[string]bool, len(views))
+	for _, view := range views {
+		if view.Name != nil {
+			names[*view.Name] = true
+		}
+	}
+
+	return names, nil
+}
+
+// memberDnsViews is the member:dns object with its list of views only: unlike ibclient.MemberDns,
+// it does not send the member's extensible attributes on an update, which would replace them.
+type memberDnsViews struct {
+	ibclient.IBBase `json:"-"`
+
+	Views []string `json:"views"`
+}
+
+func (*memberDnsViews) ObjectType() string {
+	return "member:dns"
+}
+
+// getMembersDNSViews returns the DNS settings of the Grid members, with the ordered lists of their views.
+func getMembersDNSViews(conn ibclient.IBConnector) ([]ibclient.MemberDns, error) {
+	md := &ibclient.MemberDns{}
+	md.SetReturnFields([]string{"host_name", "views"})
+
+	var members []ibclient.MemberDns
+	if err := conn.GetObject(md, "", ibclient.NewQueryParams(false, nil), &members); err != nil {
+		return nil, fmt.Errorf("failed to get DNS views of Grid members: %w", err)
+	}
+
+	return members, nil
+}
+
+// networkViewPositions returns the positions of the network view's DNS views
+// in a member's list of views, and the position of the view 'name' among them (-1 if it is not there).
+func networkViewPositions(memberViews []string, names map[string]bool, name string) ([]int, int) {
+	var positions []int
+	idx := -1
+	for i, view := range memberViews {
+		if !names[view] {
+			continue
+		}
+		if view == name {
+			idx = len(positions)
+		}
+		positions = append(positions, i)
+	}
+
+	return positions, idx
+}
+
+// getDNSViewOrder returns the position of the DNS view among the views of its network view,
+// as the first of the Grid members which serve the view reports it; 'found' is false
+// if no member serves the view.
+func getDNSViewOrder(conn ibclient.IBConnector, name, networkView string) (order int, found bool, err error) {
+	names, err := getDNSViewNames(conn, networkView)
+	if err != nil {
+		return 0, false, err
+	}
+	members, err := getMembersDNSViews(conn)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, member := range members {
+		if _, idx := networkViewPositions(member.Views, names, name); idx >= 0 {
+			return idx, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// setDNSViewOrder moves the DNS view to the given position among the views of its network view
+// on every Grid member which serves the view; the order of the other views is kept.
+func setDNSViewOrder(conn ibclient.IBConnector, name, networkView string, order int) error {
+	names, err := getDNSViewNames(conn, networkView)
+	if err != nil {
+		return err
+	}
+	members, err := getMembersDNSViews(conn)
+	if err != nil {
+		return err
+	}
+
+	// The new lists of views are formed for all the members first, so that an invalid order changes none of them.
+	var updates []ibclient.MemberDns
+	for _, member := range members {
+		positions, idx := networkViewPositions(member.Views, names, name)
+		if idx < 0 {
+			continue
+		}
+		if order >= len(positions) {
+			return fmt.Errorf(
+				"'order' must be less than %d, the number of DNS views of network view '%s' served by Grid member '%s'",
+				len(positions), networkView, member.HostName)
+		}
+		if order == idx {
+			continue
+		}
+
+		ordered := make([]string, 0, len(positions))
+		for _, p := range positions {
+			if member.Views[p] != name {
+				ordered = append(ordered, member.Views[p])
+			}
+		}
+		ordered = append(ordered[:order], append([]string{name}, ordered[order:]...)...)
+
+		member.Views = append([]string(nil), member.Views...)
+		for i, p := range positions {
+			member.Views[p] = ordered[i]
+		}
+		updates = append(updates, member)
+	}
+
+	for _, member := range updates {
+		if _, err = conn.UpdateObject(&memberDnsViews{Views: member.Views}, member.Ref); err != nil {
+			return fmt.Errorf("failed to set the order of DNS views on Grid member '%s': %w", member.HostName, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceDNSViewCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if intId := d.Get("internal_id"); intId.(string) != "" {
 		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
@@ -99,18 +695,11 @@ func resourceDNSViewCreate(ctx context.Context, d *schema.ResourceData, m interf
 	internalId := generateInternalId()
 	extAttrs[eaNameForInternalId] = internalId.String()
 
-	v := &ibclient.View{
-		Name: utils.StringPtr(d.Get("name").(string)),
-		Ea:   extAttrs,
-	}
-
-	if d.HasChange("comment") {
-		v.Comment = utils.StringPtr(d.Get("comment").(string))
-	}
-
-	if d.HasChange("network_view") {
-		v.NetworkView = utils.StringPtr(d.Get("network_view").(string))
+	v, err := formDNSView(true, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	v.Ea = extAttrs
 
 	viewRef, err := conn.CreateObject(v)
 	if err != nil {
@@ -126,19 +715,23 @@ func resourceDNSViewCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
+	if dnsViewFieldIsSet(true, d, "order") {
+		err = setDNSViewOrder(conn, d.Get("name").(string), d.Get("network_view").(string), d.Get("order").(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceDNSViewRead(ctx, d, m)
 }
 
 func resourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-
-	v := &ibclient.View{}
-	v.SetReturnFields([]string{"name", "comment", "network_view", "extattrs"})
-
 	if !dnsViewRegExp.MatchString(d.Id()) {
 		return diag.FromErr(fmt.Errorf("reference '%s' for 'view' object has an invalid format", d.Id()))
 	}
 
-	rec, err := searchObjectByRefOrInternalId("DNSView", d, m)
+	var vResult dnsView
+	err := searchGenericObjectByRefOrInternalId(newEmptyDNSView(), d, m, &vResult)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
 			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
@@ -149,28 +742,19 @@ func resourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interfac
 		}
 	}
 
-	// Assertion of object type and error handling
-	var vResult *ibclient.View
-	recJson, _ := json.Marshal(rec)
-	err = json.Unmarshal(recJson, &vResult)
-
-	if err != nil && vResult.Ref != "" {
-		return diag.FromErr(fmt.Errorf("getting DNS View with ID: %s failed: %w", d.Id(), err))
-	}
-
-	if err = d.Set("name", vResult.Name); err != nil {
+	if err = setDNSViewFields(d, &vResult); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if vResult.Comment != nil {
-		if err = d.Set("comment", vResult.Comment); err != nil {
+	if vResult.Name != nil && vResult.NetworkView != nil {
+		order, found, err := getDNSViewOrder(m.(ibclient.IBConnector), *vResult.Name, *vResult.NetworkView)
+		if err != nil {
 			return diag.FromErr(err)
 		}
-	}
-
-	if vResult.NetworkView != nil {
-		if err = d.Set("network_view", vResult.NetworkView); err != nil {
-			return diag.FromErr(err)
+		if found {
+			if err = d.Set("order", order); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
@@ -247,18 +831,11 @@ func resourceDNSViewUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	vUpd := &ibclient.View{
-		Name: utils.StringPtr(d.Get("name").(string)),
-	}
 
-	if d.HasChange("comment") {
-		vUpd.Comment = utils.StringPtr(d.Get("comment").(string))
-	}
-
-	if d.HasChange("network_view") {
-		vUpd.NetworkView = utils.StringPtr(d.Get("network_view").(string))
+	vUpd, err := formDNSView(false, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-
 	vUpd.Ea = mergedExtAttrs
 
 	viewRef, err := conn.UpdateObject(vUpd, d.Id())
@@ -275,13 +852,21 @@ func resourceDNSViewUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("order") {
+		err = setDNSViewOrder(conn, d.Get("name").(string), d.Get("network_view").(string), d.Get("order").(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceDNSViewRead(ctx, d, m)
 }
 
 func resourceDNSViewDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	conn := m.(ibclient.IBConnector)
 
-	rec, err := searchObjectByRefOrInternalId("DNSView", d, m)
+	var vResult dnsView
+	err := searchGenericObjectByRefOrInternalId(newEmptyDNSView(), d, m, &vResult)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
 			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
@@ -292,15 +877,6 @@ func resourceDNSViewDelete(ctx context.Context, d *schema.ResourceData, m interf
 		}
 	}
 
-	// Assertion of object type and error handling
-	var vResult *ibclient.View
-	recJson, _ := json.Marshal(rec)
-	err = json.Unmarshal(recJson, &vResult)
-
-	if err != nil && vResult.Ref != "" {
-		return diag.FromErr(fmt.Errorf("getting DNS View with ID: %s failed: %w", d.Id(), err))
-	}
-
 	if _, err := conn.DeleteObject(vResult.Ref); err != nil {
 		return diag.FromErr(fmt.Errorf("deletion of DNS View failed: %w", err))
 	}
@@ -313,36 +889,21 @@ func resourceDNSViewImport(d *schema.ResourceData, m interface{}) ([]*schema.Res
 
 	viewRef := d.Id()
 
-	v := &ibclient.View{}
-	v.SetReturnFields([]string{"name", "comment", "network_view", "extattrs"})
-
-	vResult := ibclient.View{}
+	var vResult dnsView
 
 	if !dnsViewRegExp.MatchString(d.Id()) {
 		return nil, fmt.Errorf("reference '%s' for 'view' object has an invalid format", d.Id())
 	}
 
-	err := conn.GetObject(v, viewRef, nil, &vResult)
+	err := conn.GetObject(newEmptyDNSView(), viewRef, nil, &vResult)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read DNS View: %w", err)
 	}
 
-	if err = d.Set("name", vResult.Name); err != nil {
+	if err = setDNSViewFields(d, &vResult); err != nil {
 		return nil, err
 	}
 
-	if vResult.Comment != nil {
-		if err = d.Set("comment", vResult.Comment); err != nil {
-			return nil, err
-		}
-	}
-
-	if vResult.NetworkView != nil {
-		if err = d.Set("network_view", vResult.NetworkView); err != nil {
-			return nil, err
-		}
-	}
-
 	extAttrsJSON := d.Get("ext_attrs").(string)
 	_, err = terraformDeserializeEAs(extAttrsJSON)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+	"regexp"
 	"testing"
 )
 
@@ -213,6 +214,98 @@ func TestAcc_resourceDNSView(t *testing.T) {
 	})
 }
 
+func TestAcc_resourceDNSViewConfiguration(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_dns_view" "internal" {
+						name = "tf_acc_internal"
+						match_clients {
+							address = "10.0.0.0/8"
+						}
+						match_destinations {
+							address = "Any"
+						}
+						recursion = true
+						forwarders = ["10.1.1.1", "10.1.1.2"]
+						forward_only = true
+						lame_ttl = 300
+						dns64 {
+							groups = ["default"]
+						}
+					}
+
+					resource "infoblox_dns_view" "external" {
+						name = "tf_acc_external"
+						match_clients {
+							address = "Any"
+						}
+						recursion = false
+						nxdomain_redirect {
+							addresses = ["10.2.2.2"]
+							ttl = 120
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "match_clients.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "match_clients.0.address", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "match_destinations.0.address", "Any"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "recursion", "true"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "forwarders.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "forwarders.1", "10.1.1.2"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "forward_only", "true"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "lame_ttl", "300"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "dns64.0.groups.0", "default"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.external", "recursion", "false"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.external", "nxdomain_redirect.0.addresses.0", "10.2.2.2"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.external", "nxdomain_redirect.0.ttl", "120"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_dns_view" "internal" {
+						name = "tf_acc_internal"
+						match_clients {
+							address = "10.0.0.0/8"
+						}
+						match_clients {
+							address = "192.168.0.0/16"
+						}
+						recursion = true
+					}
+
+					resource "infoblox_dns_view" "external" {
+						name = "tf_acc_external"
+						match_clients {
+							address = "Any"
+						}
+						recursion = false
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "match_clients.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "match_destinations.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "forwarders.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "forward_only", "false"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.internal", "dns64.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_dns_view.external", "nxdomain_redirect.#", "0"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_dns_view" "internal" {
+						name = "tf_acc_internal"
+						forward_only = true
+					}`,
+				ExpectError: regexp.MustCompile("'forward_only' requires at least one forwarder to be set"),
+			},
+		},
+	})
+}
+
 func validateDnsView(
 	resourceName string,
 	expectedValue *ibclient.View) resource.TestCheckFunc {
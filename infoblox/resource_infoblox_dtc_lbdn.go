@@ -41,9 +41,10 @@ func resourceDtcLbdnRecord() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"fqdn": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Fully qualified domain name of an Authoritative zone.",
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "Fully qualified domain name of an Authoritative zone.",
+							DiffSuppressFunc: suppressDnsNameDiff,
 						},
 						"dns_view": {
 							Type:        schema.TypeString,
@@ -189,7 +190,7 @@ func validateAuthZonesLink(authZones []interface{}) ([]ibclient.AuthZonesLink, e
 		// Create a new AuthZone and populate its fields
 		authZone := ibclient.AuthZonesLink{}
 		if fqdn, ok := authZoneMap["fqdn"].(string); ok {
-			authZone.Fqdn = fqdn
+			authZone.Fqdn = dnsNameForWapi(fqdn)
 		}
 		if dnsView, ok := authZoneMap["dns_view"].(string); ok {
 			authZone.DnsView = dnsView
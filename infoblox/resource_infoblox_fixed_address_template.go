@@ -0,0 +1,46 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFixedAddressTemplate() *schema.Resource {
+	t := &dhcpTemplate{
+		objType: "fixedaddresstemplate",
+		title:   "fixed address template",
+		fields: map[string]*schema.Schema{
+			"offset": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The offset, from the network's address, of the first fixed address created out of the template.",
+			},
+			"number_of_addresses": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of the fixed addresses created out of the template.",
+			},
+		},
+		returnFields: []string{"offset", "number_of_addresses"},
+	}
+
+	t.formData = func(d *schema.ResourceData) (map[string]interface{}, error) {
+		return map[string]interface{}{
+			"offset":              d.Get("offset").(int),
+			"number_of_addresses": d.Get("number_of_addresses").(int),
+		}, nil
+	}
+
+	t.setFields = func(d *schema.ResourceData, rec map[string]interface{}) error {
+		if err := d.Set("offset", wapiInt(rec, "offset")); err != nil {
+			return err
+		}
+
+		return d.Set("number_of_addresses", wapiInt(rec, "number_of_addresses"))
+	}
+
+	return t.resource()
+}
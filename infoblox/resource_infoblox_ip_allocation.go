@@ -1,7 +1,7 @@
 package infoblox
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"sort"
@@ -46,6 +46,10 @@ func resourceIPAllocation() *schema.Resource {
 			State: ipAllocationImporter,
 		},
 
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return dnsNameFormsCustomizeDiff(d, "fqdn")
+		},
+
 		Schema: map[string]*schema.Schema{
 			"network_view": {
 				Type:        schema.TypeString,
@@ -89,7 +93,7 @@ func resourceIPAllocation() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "Value which comes from 'ipv4_addr' (if specified) or from auto-allocation function (using 'ipv4_cidr' or 'filter_params').",
+				Description: "Value which comes from 'ipv4_addr' (if specified) or from auto-allocation function (using 'ipv4_cidr', 'filter_params' or the DHCP range).",
 			},
 			"ipv6_addr": {
 				Type:     schema.TypeString,
@@ -108,32 +112,40 @@ func resourceIPAllocation() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "Value which comes from 'ipv6_addr' (if specified) or from auto-allocation function (using 'ipv6_cidr' or 'filter_params').",
+				Description: "Value which comes from 'ipv6_addr' (if specified) or from auto-allocation function (using 'ipv6_cidr', 'filter_params' or the DHCP range).",
 			},
 			"fqdn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The host name for Host Record in FQDN format.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The host name for Host Record in FQDN format.",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
+			"fqdn_ascii":   dnsNameAsciiSchema("fqdn"),
+			"fqdn_unicode": dnsNameUnicodeSchema("fqdn"),
 			"filter_params": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The parent network block's extensible attributes. This field is used for dynamic allocation along with 'ip_address_type' field.",
 			},
+			"range_start":         rangeStartSchema,
+			"range_end":           rangeEndSchema,
+			"range_filter_params": rangeFilterParamsSchema,
+			"exclude":             excludeSchema,
 			"ip_address_type": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The type of IP address to allocate. This filed is used only when 'filter_params' field is used. Valid values are: IPV4, IPV6, Both. Default value is IPV4",
+				Description: "The type of IP address to allocate. This filed is used only when 'filter_params' or 'range_filter_params' field is used. Valid values are: IPV4, IPV6, Both. Default value is IPV4",
 				ValidateFunc: validation.StringInSlice([]string{
 					"IPV4", "IPV6", "Both",
 				}, false),
 				Default: "IPV4",
 				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
-					if d.Get("filter_params") != "" && newValue == "" {
+					byFilter := d.Get("filter_params") != "" || d.Get("range_filter_params") != ""
+					if byFilter && newValue == "" {
 						if oldValue == "IPV4" {
 							return true
 						}
-					} else if d.Get("filter_params") == "" {
+					} else if !byFilter {
 						return true
 					}
 					return oldValue == newValue
@@ -190,7 +202,7 @@ func resourceIPAllocation() *schema.Resource {
 						return true
 					}
 					enableDNS := d.Get("enable_dns").(bool)
-					fqdn := d.Get("fqdn").(string)
+					fqdn := dnsNameForWapi(d.Get("fqdn").(string))
 					domain := strings.Join(strings.Split(fqdn, ".")[1:], ".")
 					oldAliases, newAliases := d.GetChange("aliases")
 					oldAliasesNew := normalizeAndSortAliases(oldAliases.([]interface{}), domain, enableDNS)
@@ -246,7 +258,7 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 	networkView := d.Get("network_view").(string)
 	dnsView := d.Get("dns_view").(string)
 	enableDns := d.Get("enable_dns").(bool)
-	fqdn := d.Get("fqdn").(string)
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
 	if intId := d.Get("internal_id"); intId.(string) != "" {
 		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
 	}
@@ -257,15 +269,23 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 	ipv6Addr := d.Get("ipv6_addr").(string)
 	nextAvailableFilter := d.Get("filter_params").(string)
 	ipAdressType := d.Get("ip_address_type").(string)
-	if nextAvailableFilter == "" {
+	rng, err := getRangeAllocation(d)
+	if err != nil {
+		return err
+	}
+	if nextAvailableFilter == "" && (rng == nil || rng.start != "") {
 		if err := d.Set("ip_address_type", ""); err != nil {
 			return err
 
 		}
 	}
-	if (ipv4Cidr == "" && ipv6Cidr == "" && ipv4Addr == "" && ipv6Addr == "") && nextAvailableFilter == "" {
+	if (ipv4Cidr == "" && ipv6Cidr == "" && ipv4Addr == "" && ipv6Addr == "") && nextAvailableFilter == "" && rng == nil {
 		return fmt.Errorf("allocation through host address record creation needs an IPv4/IPv6 address" +
-			" or IPv4/IPv6 cidr or filter_params")
+			" or IPv4/IPv6 cidr or filter_params or a DHCP range")
+	}
+	if rng != nil && (ipv4Cidr != "" || ipv6Cidr != "" || ipv4Addr != "" || ipv6Addr != "" || nextAvailableFilter != "") {
+		return fmt.Errorf("the DHCP range to allocate the IP address from is not allowed to be defined" +
+			" along with IPv4/IPv6 address, IPv4/IPv6 cidr or filter_params")
 	}
 
 	ZeroMacAddr := "00:00:00:00:00:00"
@@ -316,21 +336,59 @@ func resourceAllocationRequest(d *schema.ResourceData, m interface{}) error {
 		eaMap         map[string]string
 	)
 
-	if ipv4Addr == "" && ipv4Cidr == "" && ipv6Cidr == "" && ipv6Addr == "" && nextAvailableFilter != "" {
-		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
-		eaMap["network_view"] = networkView
+	if rng != nil {
+		// The IP version of the range selected by its addresses is that of the addresses.
+		if rng.start != "" {
+			isIPv6, err := rng.isIPv6(connector, networkView)
+			if err != nil {
+				return err
+			}
+			ipAdressType = "IPV4"
+			if isIPv6 {
+				ipAdressType = "IPV6"
+			}
+		}
+		rec := ibclient.NewIpNextAvailable(fqdn, "record:host", nil, nil, false, extAttrs,
+			comment, disable, nil, ipAdressType, enableDns, false, "", "", networkView, dnsView, useTtl, ttl, aliasStrs)
+		for i := range rec.NextAvailableIPv4Addrs {
+			rng.setNextAvailableIp(&rec.NextAvailableIPv4Addrs[i].NextavailableIPv4Addr, false, networkView)
+		}
+		for i := range rec.NextAvailableIPv6Addrs {
+			rng.setNextAvailableIp(&rec.NextAvailableIPv6Addrs[i].NextavailableIPv6Addr, true, networkView)
+		}
+		var ref string
+		if ref, err = allocateInRange(m, networkView, rec); err == nil {
+			newRecordHost, err = objMgr.GetHostRecordByRef(ref)
+		}
+		if rng.start == "" {
+			d.Set("ip_address_type", ipAdressType)
+		}
+	} else if ipv4Addr == "" && ipv4Cidr == "" && ipv6Cidr == "" && ipv6Addr == "" && nextAvailableFilter != "" {
+		eaMap, err = parseNextAvailableFilter(nextAvailableFilter, networkView)
 		if err != nil {
 			return fmt.Errorf("error unmarshalling extra attributes of network: %s", err)
 		}
-		newRecordHost, err = objMgr.AllocateNextAvailableIp(fqdn, "record:host", eaMap, nil, false, extAttrs,
-			comment, disable, nil, ipAdressType, enableDns, false, "", "", networkView, dnsView, useTtl, ttl, aliasStrs)
+		err = withAllocationLock(m, networkView, func() (err error) {
+			newRecordHost, err = objMgr.AllocateNextAvailableIp(fqdn, "record:host", eaMap, nil, false, extAttrs,
+				comment, disable, nil, ipAdressType, enableDns, false, "", "", networkView, dnsView, useTtl, ttl, aliasStrs)
+			return
+		})
 		d.Set("ip_address_type", ipAdressType)
 	} else {
 
 		// enableDns and enableDhcp flags used to create host record with respective flags.
 		// By default, enableDns is true.
-		newRecordHost, err = objMgr.CreateHostRecord(enableDns, false, fqdn, networkView, dnsView, ipv4Cidr,
-			ipv6Cidr, ipv4Addr, ipv6Addr, macAddr, "", useTtl, ttl, comment, extAttrs, aliasStrs, disable)
+		create := func() (err error) {
+			newRecordHost, err = objMgr.CreateHostRecord(enableDns, false, fqdn, networkView, dnsView, ipv4Cidr,
+				ipv6Cidr, ipv4Addr, ipv6Addr, macAddr, "", useTtl, ttl, comment, extAttrs, aliasStrs, disable)
+			return
+		}
+		// The next available IP addresses of the networks are allocated if the networks are specified.
+		if (ipv4Addr == "" && ipv4Cidr != "") || (ipv6Addr == "" && ipv6Cidr != "") {
+			err = withAllocationLock(m, networkView, create)
+		} else {
+			err = create()
+		}
 	}
 
 	if err != nil {
@@ -393,6 +451,8 @@ func resourceAllocationGet(d *schema.ResourceData, m interface{}) error {
 	}
 
 	_, nextAvailableFilterOk := d.GetOk("filter_params")
+	// The addresses allocated from a DHCP range are dynamic the same way as those allocated by 'filter_params'.
+	nextAvailableFilterOk = nextAvailableFilterOk || isRangeAllocation(d)
 	if obj.Ipv6Addrs == nil || len(obj.Ipv6Addrs) < 1 {
 		if err := d.Set("allocated_ipv6_addr", ""); err != nil {
 			return err
@@ -492,6 +552,10 @@ func resourceAllocationGet(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := setDnsNameForms(d, "fqdn"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -533,6 +597,7 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("disable", prevDisable.(bool))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			revertRangeAllocationFields(d)
 		}
 	}()
 
@@ -561,11 +626,14 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 	if d.HasChange("ip_address_type") {
 		return fmt.Errorf("changing the value of 'ip_address_type' field is not allowed")
 	}
+	if err := checkRangeAllocationUpdate(d); err != nil {
+		return err
+	}
 
 	enableDNS := d.Get("enable_dns").(bool)
 	dnsView := d.Get("dns_view").(string)
 	dnsView = strings.TrimSpace(dnsView)
-	fqdn := d.Get("fqdn").(string)
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
 	aliases := d.Get("aliases").([]interface{})
 	aliasStrs := make([]string, len(aliases))
 	for i, alias := range aliases {
@@ -593,6 +661,8 @@ func resourceAllocationUpdate(d *schema.ResourceData, m interface{}) (err error)
 	ipv4Addr := d.Get("ipv4_addr").(string)
 	ipv6Addr := d.Get("ipv6_addr").(string)
 	_, nextAvailableFilterOk := d.GetOk("filter_params")
+	// The addresses allocated from a DHCP range are dynamic the same way as those allocated by 'filter_params'.
+	nextAvailableFilterOk = nextAvailableFilterOk || isRangeAllocation(d)
 
 	// If 'ipv4_cidr' or 'ipv6_cidr' are unchanged, then nothing to update here.
 	// making them empty to skip dynamic allocation of a new IP address again.
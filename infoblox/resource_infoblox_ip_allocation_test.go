@@ -1017,3 +1017,69 @@ func TestAcc_resourceNetwork_AllocateNetworkByEA_IPV4_IP_Allocation(t *testing.T
 		},
 	})
 }
+
+func TestAcc_resourceIPAllocation_range(t *testing.T) {
+	netConfig := `
+		resource "infoblox_zone_auth" "zone1" {
+			fqdn = "test.com"
+		}
+		resource "infoblox_ipv4_network" "net1" {
+			cidr = "10.41.0.0/24"
+		}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIPAllocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: netConfig,
+			},
+			{
+				PreConfig: func() {
+					testAccCreateRange("10.41.0.0/24", "10.41.0.100", "10.41.0.150", ibclient.EA{"Site": "Appliances"})
+				},
+				Config: netConfig + `
+				resource "infoblox_ip_allocation" "range1" {
+					fqdn = "range1.test.com"
+					range_filter_params = jsonencode({
+						"*Site" = "Appliances"
+					})
+					exclude = ["10.41.0.100"]
+					depends_on = [infoblox_zone_auth.zone1, infoblox_ipv4_network.net1]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ip_allocation.range1", "allocated_ipv4_addr", "10.41.0.101"),
+					resource.TestCheckResourceAttr("infoblox_ip_allocation.range1", "ip_address_type", "IPV4"),
+				),
+			},
+			{
+				Config: netConfig + `
+				resource "infoblox_ip_allocation" "range1" {
+					fqdn = "range1.test.com"
+					range_filter_params = jsonencode({
+						"*Site" = "Appliances"
+					})
+					exclude = ["10.41.0.100"]
+					comment = "allocated from the range"
+					depends_on = [infoblox_zone_auth.zone1, infoblox_ipv4_network.net1]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ip_allocation.range1", "allocated_ipv4_addr", "10.41.0.101"),
+					resource.TestCheckResourceAttr("infoblox_ip_allocation.range1", "comment", "allocated from the range"),
+				),
+			},
+			{
+				Config: netConfig + `
+				resource "infoblox_ip_allocation" "range1" {
+					fqdn = "range1.test.com"
+					ipv4_cidr = "10.41.0.0/24"
+					range_start = "10.41.0.100"
+					range_end = "10.41.0.150"
+					depends_on = [infoblox_zone_auth.zone1, infoblox_ipv4_network.net1]
+				}`,
+				ExpectError: regexp.MustCompile("changing the value of 'range_start' field is not allowed"),
+			},
+		},
+	})
+}
@@ -25,6 +25,9 @@ func resourceMXRecord() *schema.Resource {
 					return err
 				}
 			}
+			if err := dnsNameFormsCustomizeDiff(d, "fqdn"); err != nil {
+				return err
+			}
 			return nil
 		},
 
@@ -36,14 +39,18 @@ func resourceMXRecord() *schema.Resource {
 				Description: "DNS view which the zone does exist within",
 			},
 			"fqdn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "FQDN for the MX-record.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "FQDN for the MX-record.",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
+			"fqdn_ascii":   dnsNameAsciiSchema("fqdn"),
+			"fqdn_unicode": dnsNameUnicodeSchema("fqdn"),
 			"mail_exchanger": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "A record used to specify mail server.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "A record used to specify mail server.",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
 			"preference": {
 				Type:        schema.TypeInt,
@@ -91,12 +98,12 @@ func resourceMXRecordCreate(d *schema.ResourceData, m interface{}) error {
 	}
 	dnsView := d.Get("dns_view").(string)
 
-	fqdn := d.Get("fqdn").(string)
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
 	if fqdn == "" {
 		return fmt.Errorf("'fqdn' must not be empty")
 	}
 
-	mx := d.Get("mail_exchanger").(string)
+	mx := dnsNameForWapi(d.Get("mail_exchanger").(string))
 	if mx == "" {
 		return fmt.Errorf("'mail_exchanger' must not be empty")
 	}
@@ -223,6 +230,10 @@ func resourceMXRecordGet(d *schema.ResourceData, m interface{}) error {
 	if err = d.Set("ref", obj.Ref); err != nil {
 		return err
 	}
+	if err := setDnsNameForms(d, "fqdn"); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
@@ -260,8 +271,8 @@ func resourceMXRecordUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
 	}
 	dnsView := d.Get("dns_view").(string)
-	fqdn := d.Get("fqdn").(string)
-	mx := d.Get("mail_exchanger").(string)
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
+	mx := dnsNameForWapi(d.Get("mail_exchanger").(string))
 
 	tempInt := d.Get("preference").(int)
 	if err := ibclient.CheckIntRange("preference", tempInt, 0, 65535); err != nil {
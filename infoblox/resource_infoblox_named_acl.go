@@ -0,0 +1,338 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+var namedAclReturnFields = []string{
+	"name",
+	"access_list",
+	"comment",
+	"extattrs",
+}
+
+func newEmptyNamedAcl() *ibclient.Namedacl {
+	acl := &ibclient.Namedacl{}
+	acl.SetReturnFields(namedAclReturnFields)
+
+	return acl
+}
+
+// namedAcl extends ibclient.Namedacl with the access list in the form NIOS accepts and returns it.
+type namedAcl struct {
+	ibclient.Namedacl
+	AccessList *aclList `json:"access_list,omitempty"`
+}
+
+func resourceNamedAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNamedAclCreate,
+		Read:   resourceNamedAclRead,
+		Update: resourceNamedAclUpdate,
+		Delete: resourceNamedAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNamedAclImport,
+		},
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the named ACL, which other objects refer to it by.",
+			},
+			"access_list": aclSchema("The ordered list of access control entries of the named ACL; " +
+				"an entry may refer to another named ACL."),
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A descriptive comment.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the named ACL, as a map in JSON format.",
+			},
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+// formNamedAcl builds a WAPI object out of the resource's data.
+func formNamedAcl(d *schema.ResourceData, m interface{}) (*namedAcl, error) {
+	accessList, err := convertAclFromInterface(m.(ibclient.IBConnector), d.Get("access_list").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("invalid value of 'access_list': %w", err)
+	}
+	list := aclList(accessList)
+
+	return &namedAcl{
+		Namedacl: ibclient.Namedacl{
+			Name:    utils.StringPtr(d.Get("name").(string)),
+			Comment: utils.StringPtr(d.Get("comment").(string)),
+		},
+		AccessList: &list,
+	}, nil
+}
+
+func setNamedAclFields(d *schema.ResourceData, acl *namedAcl) error {
+	name := ""
+	if acl.Name != nil {
+		name = *acl.Name
+	}
+	if err := d.Set("name", name); err != nil {
+		return err
+	}
+
+	var accessList []interface{}
+	if acl.AccessList != nil {
+		var err error
+		if accessList, err = convertAclToInterface(*acl.AccessList); err != nil {
+			return fmt.Errorf("failed to read 'access_list': %w", err)
+		}
+	}
+	if err := d.Set("access_list", accessList); err != nil {
+		return err
+	}
+
+	comment := ""
+	if acl.Comment != nil {
+		comment = *acl.Comment
+	}
+	if err := d.Set("comment", comment); err != nil {
+		return err
+	}
+
+	return d.Set("ref", acl.Ref)
+}
+
+func resourceNamedAclCreate(d *schema.ResourceData, m interface{}) error {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+	}
+
+	acl, err := formNamedAcl(d, m)
+	if err != nil {
+		return err
+	}
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	acl.Ea = extAttrs
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(acl)
+	if err != nil {
+		return fmt.Errorf("failed to create named ACL: %s", err)
+	}
+
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return err
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+
+	return resourceNamedAclRead(d, m)
+}
+
+func resourceNamedAclRead(d *schema.ResourceData, m interface{}) error {
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	var acl namedAcl
+	err = searchGenericObjectByRefOrInternalId(newEmptyNamedAcl(), d, m, &acl)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if err = setNamedAclFields(d, &acl); err != nil {
+		return err
+	}
+
+	delete(acl.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(acl.Ea, extAttrs)
+
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(acl.Ref)
+
+	return nil
+}
+
+func resourceNamedAclUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			for _, field := range []string{"name", "access_list", "comment", "ext_attrs"} {
+				prevVal, _ := d.GetChange(field)
+				_ = d.Set(field, prevVal)
+			}
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+	}
+
+	acl, err := formNamedAcl(d, m)
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
+
+	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	var currentAcl namedAcl
+	err = searchGenericObjectByRefOrInternalId(newEmptyNamedAcl(), d, m, &currentAcl)
+	if err != nil {
+		return fmt.Errorf("failed to read named ACL for update operation: %w", err)
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	acl.Ea, err = mergeEAs(currentAcl.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return err
+	}
+
+	ref, err := connector.UpdateObject(acl, currentAcl.Ref)
+	if err != nil {
+		return fmt.Errorf("failed to update named ACL: %s", err)
+	}
+	updateSuccessful = true
+
+	d.SetId(ref)
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return err
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+
+	return resourceNamedAclRead(d, m)
+}
+
+func resourceNamedAclDelete(d *schema.ResourceData, m interface{}) error {
+	var acl namedAcl
+	err := searchGenericObjectByRefOrInternalId(newEmptyNamedAcl(), d, m, &acl)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	connector := m.(ibclient.IBConnector)
+	if _, err = connector.DeleteObject(acl.Ref); err != nil {
+		return fmt.Errorf("failed to delete named ACL: %s", err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceNamedAclImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(ibclient.IBConnector)
+
+	var acl namedAcl
+	if err := connector.GetObject(newEmptyNamedAcl(), d.Id(), ibclient.NewQueryParams(false, nil), &acl); err != nil {
+		return nil, fmt.Errorf("failed getting named ACL: %w", err)
+	}
+
+	if err := setNamedAclFields(d, &acl); err != nil {
+		return nil, err
+	}
+
+	if acl.Ea != nil && len(acl.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(acl.Ea)
+		if err != nil {
+			return nil, err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(acl.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if err := resourceNamedAclUpdate(d, m); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
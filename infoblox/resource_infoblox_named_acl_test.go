@@ -0,0 +1,137 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckNamedAclDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_named_acl" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var acl namedAcl
+		err := connector.GetObject(newEmptyNamedAcl(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &acl)
+		if err == nil && acl.Ref != "" {
+			return fmt.Errorf("named ACL still exists")
+		}
+	}
+	return nil
+}
+
+func testAccNamedAclCompare(t *testing.T, resPath string, expectedName string, expectedEntries int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.Attributes["internal_id"] == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		var acl namedAcl
+		err := connector.GetObject(newEmptyNamedAcl(), res.Primary.Attributes["ref"], ibclient.NewQueryParams(false, nil), &acl)
+		if err != nil {
+			return err
+		}
+
+		if acl.Name == nil || *acl.Name != expectedName {
+			return fmt.Errorf("'name' does not match: expected '%s'", expectedName)
+		}
+		if acl.AccessList == nil || len(*acl.AccessList) != expectedEntries {
+			return fmt.Errorf("'access_list' is expected to have %d entries", expectedEntries)
+		}
+
+		return nil
+	}
+}
+
+func TestAccResourceNamedAcl(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNamedAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_named_acl" "internal" {
+						name = "tf-acc-internal"
+						comment = "internal networks"
+						access_list {
+							address = "10.0.0.0/8"
+						}
+						access_list {
+							address = "2001:db8::/32"
+						}
+						access_list {
+							address = "10.1.1.1"
+							permission = "DENY"
+						}
+					}
+
+					resource "infoblox_named_acl" "transfer" {
+						name = "tf-acc-transfer"
+						access_list {
+							tsig_key_name = "transfer-key"
+							tsig_key = "X4oRe92t54I+T98NdQpV2w=="
+							tsig_key_alg = "HMAC-SHA256"
+						}
+						access_list {
+							named_acl = infoblox_named_acl.internal.name
+						}
+						ext_attrs = jsonencode({
+							"Location" = "Test loc."
+						})
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNamedAclCompare(t, "infoblox_named_acl.internal", "tf-acc-internal", 3),
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "comment", "internal networks"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "access_list.0.address", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "access_list.0.permission", "ALLOW"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "access_list.2.permission", "DENY"),
+					testAccNamedAclCompare(t, "infoblox_named_acl.transfer", "tf-acc-transfer", 2),
+					resource.TestCheckResourceAttr("infoblox_named_acl.transfer", "access_list.0.tsig_key_name", "transfer-key"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.transfer", "access_list.0.tsig_key_alg", "HMAC-SHA256"),
+					resource.TestCheckResourceAttr("infoblox_named_acl.transfer", "access_list.1.named_acl", "tf-acc-internal"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_named_acl" "internal" {
+						name = "tf-acc-internal-renamed"
+						access_list {
+							address = "Any"
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccNamedAclCompare(t, "infoblox_named_acl.internal", "tf-acc-internal-renamed", 1),
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "comment", ""),
+					resource.TestCheckResourceAttr("infoblox_named_acl.internal", "access_list.0.address", "Any"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_named_acl" "internal" {
+						name = "tf-acc-internal-renamed"
+						access_list {
+							address = "10.0.0.0/8"
+							named_acl = "other"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("exactly one of 'address', 'tsig_key_name' and 'named_acl' must be set"),
+			},
+		},
+	})
+}
@@ -1,6 +1,7 @@
 package infoblox
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"net"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -39,7 +41,10 @@ func resourceNetwork() *schema.Resource {
 					return err
 				}
 			}
-			return nil
+			if err := networkCidrCustomizeDiff(d); err != nil {
+				return err
+			}
+			return networkJoinedCidrsCustomizeDiff(d, meta)
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -110,13 +115,30 @@ func resourceNetwork() *schema.Resource {
 				Optional:    true,
 				Description: "Gateway's IP address of the network. By default, the first IP address is set as gateway address; if the value is 'none' then the network has no gateway.",
 				Computed:    true,
-				// TODO: implement full support for this field
 			},
 			"comment": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "A string describing the network",
 			},
+			"joined_networks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The networks which the last expansion of the network, by a change of 'cidr' field, joined into it; " +
+					"they are shown at plan time.",
+			},
+			"template": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of the network template the network is created out of, along with its DHCP ranges and fixed addresses; " +
+					"it is used at creation only. The gateway and the reserved IP addresses are added to the ones the template creates.",
+			},
+			"dhcp_members":              networkDhcpMembersSchema,
+			"dhcp_options":              networkDhcpOptionsSchema,
+			"ddns":                      networkDdnsSchema,
+			"pxe":                       networkPxeSchema,
+			"dhcp_failover_association": networkDhcpFailoverSchema,
 			"ext_attrs": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -139,6 +161,254 @@ func resourceNetwork() *schema.Resource {
 	}
 }
 
+// networkStateVar is the state variable of the multiple-object request which creates a network,
+// it holds the network's CIDR for the requests which reserve IP addresses in it.
+const networkStateVar = "NETWORK"
+
+type networkCreateResult struct {
+	ref           string
+	cidr          string
+	reservedAddrs []string
+}
+
+// createNetworkWithReservations creates the network, the fixed address of its gateway (unless the gateway
+// is empty or 'none') and the given number of fixed addresses which reserve the next available IP addresses
+// of the network, in a single multiple-object request: either all the objects are created or none of them.
+// 'network' is the network's object to post, its CIDR may be a next-available function;
+// 'fields' are the other fields of the network to set, like its DHCP settings.
+func createNetworkWithReservations(
+	connector ibclient.IBConnector,
+	network interface{},
+	fields map[string]interface{},
+	isIPv6 bool,
+	networkView string,
+	gateway string,
+	reserveCount int) (*networkCreateResult, error) {
+
+	if len(fields) > 0 {
+		networkJSON, err := json.Marshal(network)
+		if err != nil {
+			return nil, err
+		}
+		var networkData map[string]interface{}
+		if err = json.Unmarshal(networkJSON, &networkData); err != nil {
+			return nil, err
+		}
+		for k, v := range fields {
+			networkData[k] = v
+		}
+		network = networkData
+	}
+
+	networkObjType, fixedAddrObjType, addrField := "network", "fixedaddress", "ipv4addr"
+	if isIPv6 {
+		networkObjType, fixedAddrObjType, addrField = "ipv6network", "ipv6fixedaddress", "ipv6addr"
+	}
+	networkCidr := "##STATE:" + networkStateVar + ":##"
+
+	items := []wapiRequestItem{{
+		Method:      "POST",
+		Object:      networkObjType,
+		Data:        network,
+		Args:        map[string]string{"_return_fields": "network"},
+		AssignState: map[string]string{networkStateVar: "network"},
+	}}
+	fixedAddrItem := func(ipAddr string, macOrDuid string) wapiRequestItem {
+		return wapiRequestItem{
+			Method: "POST",
+			Object: fixedAddrObjType,
+			Data: ibclient.NewFixedAddress(
+				networkView, "", ipAddr, networkCidr, macOrDuid, "", nil, "", isIPv6, ""),
+			Args:               map[string]string{"_return_fields": addrField},
+			EnableSubstitution: true,
+		}
+	}
+
+	if gateway != "" && gateway != "none" {
+		items = append(items, fixedAddrItem(gateway, ibclient.MACADDR_ZERO))
+	}
+	reservedFrom := len(items)
+	nextAvailableIp := fmt.Sprintf("func:nextavailableip:%s,%s", networkCidr, networkView)
+	for i := 1; i <= reserveCount; i++ {
+		macOrDuid := ibclient.MACADDR_ZERO
+		if isIPv6 {
+			macOrDuid = fmt.Sprintf("00:%.2x", i)
+		}
+		items = append(items, fixedAddrItem(nextAvailableIp, macOrDuid))
+	}
+
+	results, err := multiRequest(connector, items)
+	if err != nil {
+		return nil, err
+	}
+
+	var networkObj struct {
+		Ref  string `json:"_ref"`
+		Cidr string `json:"network"`
+	}
+	if err = json.Unmarshal(results[0], &networkObj); err != nil {
+		return nil, fmt.Errorf("failed to parse the created network: %w", err)
+	}
+	res := &networkCreateResult{ref: networkObj.Ref, cidr: networkObj.Cidr}
+
+	for _, r := range results[reservedFrom:] {
+		var fixedAddr map[string]interface{}
+		if err = json.Unmarshal(r, &fixedAddr); err != nil {
+			return nil, fmt.Errorf("failed to parse the reserved IP address: %w", err)
+		}
+		addr, _ := fixedAddr[addrField].(string)
+		res.reservedAddrs = append(res.reservedAddrs, addr)
+	}
+
+	return res, nil
+}
+
+// isReservationDuid tells whether the DUID is one of those which the provider assigns
+// to the fixed addresses of IPv6 networks which reserve IP addresses, like '00:01'.
+func isReservationDuid(duid string) bool {
+	_, err := parseReservationDuid(duid)
+	return err == nil
+}
+
+func parseReservationDuid(duid string) (int, error) {
+	var i int
+	if _, err := fmt.Sscanf(duid, "00:%x", &i); err != nil || fmt.Sprintf("00:%.2x", i) != duid {
+		return 0, fmt.Errorf("'%s' is not a DUID of a reserved IP address", duid)
+	}
+	return i, nil
+}
+
+// networkReservationItems returns the requests which move the fixed address of the network's gateway
+// from the old gateway's IP address to the new one and add or remove the fixed addresses which reserve
+// IP addresses of the network, to have 'newReserveCount' of them.
+// The gateway's fixed address is told from the reserved ones as the one with the old gateway's IP address
+// while there are more such fixed addresses than 'oldReserveCount'; otherwise the gateway is
+// the first reserved IP address, which is kept reserved then.
+func networkReservationItems(
+	connector ibclient.IBConnector,
+	networkView string,
+	cidr string,
+	isIPv6 bool,
+	oldGateway string,
+	newGateway string,
+	oldReserveCount int,
+	newReserveCount int) ([]wapiRequestItem, error) {
+
+	fixedAddrObjType := "fixedaddress"
+	sf := map[string]string{
+		"network_view": networkView,
+		"network":      cidr,
+	}
+	if isIPv6 {
+		fixedAddrObjType = "ipv6fixedaddress"
+	} else {
+		sf["mac"] = ibclient.MACADDR_ZERO
+	}
+
+	var fixedAddrs []ibclient.FixedAddress
+	err := connector.GetObject(ibclient.NewEmptyFixedAddress(isIPv6), "", ibclient.NewQueryParams(false, sf), &fixedAddrs)
+	if err != nil && !isNotFoundError(err) {
+		return nil, fmt.Errorf("failed to get the reserved IP addresses of network '%s': %w", cidr, err)
+	}
+
+	addrOf := func(fa ibclient.FixedAddress) net.IP {
+		if isIPv6 {
+			return net.ParseIP(fa.IPv6Address)
+		}
+		return net.ParseIP(fa.IPv4Address)
+	}
+
+	var (
+		gatewayFixedAddr *ibclient.FixedAddress
+		reserved         []ibclient.FixedAddress
+		maxDuidIndex     int
+	)
+	for i, fa := range fixedAddrs {
+		if isIPv6 && fa.Duid != ibclient.MACADDR_ZERO && !isReservationDuid(fa.Duid) {
+			continue
+		}
+		if idx, err := parseReservationDuid(fa.Duid); err == nil && idx > maxDuidIndex {
+			maxDuidIndex = idx
+		}
+		if oldGateway != "" && addrOf(fa).Equal(net.ParseIP(oldGateway)) {
+			gatewayFixedAddr = &fixedAddrs[i]
+		}
+		reserved = append(reserved, fa)
+	}
+	if gatewayFixedAddr != nil {
+		separate := len(reserved) > oldReserveCount
+		if isIPv6 {
+			separate = gatewayFixedAddr.Duid == ibclient.MACADDR_ZERO
+		}
+		if separate {
+			for i := range reserved {
+				if reserved[i].Ref == gatewayFixedAddr.Ref {
+					reserved = append(reserved[:i], reserved[i+1:]...)
+					break
+				}
+			}
+		} else {
+			gatewayFixedAddr = nil
+		}
+	}
+
+	fixedAddrItem := func(ipAddr string, macOrDuid string) wapiRequestItem {
+		return wapiRequestItem{
+			Method: "POST",
+			Object: fixedAddrObjType,
+			Data:   ibclient.NewFixedAddress(networkView, "", ipAddr, cidr, macOrDuid, "", nil, "", isIPv6, ""),
+		}
+	}
+
+	var items []wapiRequestItem
+	if oldGateway != newGateway {
+		if gatewayFixedAddr != nil {
+			items = append(items, wapiRequestItem{Method: "DELETE", Object: gatewayFixedAddr.Ref})
+		}
+
+		newGatewayReserved := false
+		for _, fa := range reserved {
+			if addrOf(fa).Equal(net.ParseIP(newGateway)) {
+				newGatewayReserved = true
+			}
+		}
+		if newGateway != "" && newGateway != "none" && !newGatewayReserved {
+			items = append(items, fixedAddrItem(newGateway, ibclient.MACADDR_ZERO))
+		}
+	}
+
+	if newReserveCount > len(reserved) {
+		nextAvailableIp := fmt.Sprintf("func:nextavailableip:%s,%s", cidr, networkView)
+		for i := len(reserved); i < newReserveCount; i++ {
+			macOrDuid := ibclient.MACADDR_ZERO
+			if isIPv6 {
+				maxDuidIndex++
+				macOrDuid = fmt.Sprintf("00:%.2x", maxDuidIndex)
+			}
+			items = append(items, fixedAddrItem(nextAvailableIp, macOrDuid))
+		}
+	} else if newReserveCount < len(reserved) {
+		// The reserved IP addresses are released starting from the last one,
+		// the gateway's IP address is kept reserved anyway.
+		sort.Slice(reserved, func(i, j int) bool {
+			return bytes.Compare(addrOf(reserved[i]).To16(), addrOf(reserved[j]).To16()) > 0
+		})
+		toRelease := len(reserved) - newReserveCount
+		for _, fa := range reserved {
+			if toRelease == 0 {
+				break
+			}
+			if newGateway != "" && addrOf(fa).Equal(net.ParseIP(newGateway)) {
+				continue
+			}
+			items = append(items, wapiRequestItem{Method: "DELETE", Object: fa.Ref})
+			toRelease--
+		}
+	}
+
+	return items, nil
+}
+
 func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) error {
 	// Check if internal_id is set manually
 	if intId := d.Get("internal_id"); intId.(string) != "" {
@@ -163,6 +433,17 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 
 	gateway := d.Get("gateway").(string)
 
+	if err := checkNetworkDhcpFields(d, isIPv6); err != nil {
+		return err
+	}
+	createFields := networkDhcpUpdateData(d, isIPv6, true)
+	if !isIPv6 && networkDhcpBlock(d, "dhcp_options") != nil {
+		createFields["options"] = networkDhcpOptions(nil, d, gateway)
+	}
+	if template := d.Get("template").(string); template != "" {
+		createFields["template"] = template
+	}
+
 	comment := d.Get("comment").(string)
 
 	extAttrsJSON := d.Get("ext_attrs").(string)
@@ -184,11 +465,17 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 		}
 	}
 
-	ZeroMacAddr := "00:00:00:00:00:00"
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
-	var network *ibclient.Network
+	reserveCount := reserveIPv4
+	if isIPv6 {
+		reserveCount = reserveIPv6
+	}
+
+	// The network is created along with its gateway's fixed address and the reserved IP addresses
+	// in a single multiple-object request, not to leave some of the objects on NIOS side if another one fails.
+	var res *networkCreateResult
 	if cidr == "" && parentCidr != "" && prefixLen > 1 {
 		_, err := objMgr.GetNetworkContainer(networkViewName, parentCidr, isIPv6, nil)
 		if err != nil {
@@ -196,33 +483,43 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 				"Allocation of network block within network container '%s' under network view '%s' failed: %s", parentCidr, networkViewName, err.Error())
 		}
 
-		network, err = objMgr.AllocateNetwork(networkViewName, parentCidr, isIPv6, uint(prefixLen), comment, extAttrs)
+		network := ibclient.NewNetwork(
+			networkViewName,
+			fmt.Sprintf("func:nextavailablenetwork:%s,%s,%d", parentCidr, networkViewName, prefixLen),
+			isIPv6, comment, extAttrs)
+		err = withAllocationLock(m, networkViewName, func() (err error) {
+			res, err = createNetworkWithReservations(connector, network, createFields, isIPv6, networkViewName, gateway, reserveCount)
+			return
+		})
 		if err != nil {
 			return fmt.Errorf("Allocation of network block failed in network view (%s) : %s", networkViewName, err)
 		}
-		if err = d.Set("cidr", network.Cidr); err != nil {
-			return err
-		}
 
 	} else if cidr == "" && nextAvailableFilter != "" && prefixLen > 1 {
-		var (
-			eaMap map[string]string
-		)
-		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
-		eaMap["network_view"] = networkViewName
+		eaMap, err := parseNextAvailableFilter(nextAvailableFilter, networkViewName)
 		if err != nil {
 			return fmt.Errorf("error unmarshalling extra attributes of network container: %s", err)
 		}
 
-		network, err = objMgr.AllocateNetworkByEA(networkViewName, isIPv6, comment, extAttrs, eaMap, uint(prefixLen), object)
+		network := &ibclient.NetworkContainerNextAvailable{
+			Network: newNextAvailableNetworkInfo(
+				nextAvailableNetworkObjType(isIPv6, object), eaMap, prefixLen),
+			NetviewName: networkViewName,
+			Comment:     comment,
+			Ea:          extAttrs,
+		}
+		err = withAllocationLock(m, networkViewName, func() (err error) {
+			res, err = createNetworkWithReservations(connector, network, createFields, isIPv6, networkViewName, gateway, reserveCount)
+			return
+		})
 		if err != nil {
 			return fmt.Errorf("allocation of network block failed in network with extra attributes (%s) : %s", nextAvailableFilter, err)
 		}
-		d.Set("cidr", network.Cidr)
 		d.Set("object", object)
 
 	} else if cidr != "" {
-		network, err = objMgr.CreateNetwork(networkViewName, cidr, isIPv6, comment, extAttrs)
+		network := ibclient.NewNetwork(networkViewName, cidr, isIPv6, comment, extAttrs)
+		res, err = createNetworkWithReservations(connector, network, createFields, isIPv6, networkViewName, gateway, reserveCount)
 		if err != nil {
 			return fmt.Errorf("Creation of network block failed in network view (%s) : %s", networkViewName, err)
 		}
@@ -230,56 +527,35 @@ func resourceNetworkCreate(d *schema.ResourceData, m interface{}, isIPv6 bool) e
 		return fmt.Errorf("creation of network block failed: neither cidr nor parentCidr with allocate_prefix_len was specified")
 	}
 
-	d.SetId(network.Ref)
+	d.SetId(res.ref)
 	if err = d.Set("internal_id", internalId.String()); err != nil {
 		return err
 	}
-	if err = d.Set("ref", network.Ref); err != nil {
+	if err = d.Set("ref", res.ref); err != nil {
 		return err
 	}
-
-	autoAllocateGateway := gateway == ""
-
-	if !autoAllocateGateway && gateway != "none" {
-		_, err = objMgr.AllocateIP(networkViewName, network.Cidr, gateway, isIPv6, ZeroMacAddr, "", "", nil)
-		if err != nil {
-			return fmt.Errorf(
-				"reservation of the IP address '%s' in network block '%s' from network view '%s' failed: %s",
-				gateway, network.Cidr, networkViewName, err.Error())
+	if cidr == "" {
+		if err = d.Set("cidr", res.cidr); err != nil {
+			return err
 		}
 	}
 
-	if isIPv6 {
-		for i := 1; i <= reserveIPv6; i++ {
-			reservedDuid := fmt.Sprintf("00:%.2x", i)
-			newAddr, err := objMgr.AllocateIP(
-				networkViewName, network.Cidr, "", isIPv6, reservedDuid, "", "", nil)
-			if err != nil {
-				return fmt.Errorf(
-					"reservation in network block '%s' from network view '%s' failed: %s",
-					network.Cidr, networkViewName, err.Error())
-			}
-			if autoAllocateGateway && i == 1 {
-				gateway = newAddr.IPv6Address
-			}
+	if gateway == "" && len(res.reservedAddrs) > 0 {
+		gateway = res.reservedAddrs[0]
+	}
+	d.Set("gateway", gateway)
+
+	// The DHCP ranges of a network exist at its creation only if the network is created out of a template.
+	if !isIPv6 {
+		items, err := networkDhcpFailoverItems(d, connector, networkViewName, d.Get("cidr").(string))
+		if err != nil {
+			return err
 		}
-	} else {
-		for i := 1; i <= reserveIPv4; i++ {
-			newAddr, err := objMgr.AllocateIP(
-				networkViewName, network.Cidr, "", isIPv6, ZeroMacAddr, "", "", nil)
-			if err != nil {
-				return fmt.Errorf(
-					"reservation in network block '%s' from network view '%s' failed: %s",
-					network.Cidr, networkViewName, err.Error())
-			}
-			if autoAllocateGateway && i == 1 {
-				gateway = newAddr.IPv4Address
-			}
+		if _, err = multiRequest(connector, items); err != nil {
+			return fmt.Errorf("failed to assign the DHCP failover association to the DHCP ranges of the network: %w", err)
 		}
 	}
 
-	d.Set("gateway", gateway)
-
 	return nil
 }
 
@@ -358,19 +634,41 @@ func resourceNetworkRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	isIPv6 := strings.HasPrefix(obj.Ref, "ipv6network/")
+	dhcp, err := getNetworkDhcpData(m.(ibclient.IBConnector), obj.Ref, isIPv6)
+	if err != nil {
+		return err
+	}
+	if err = setNetworkDhcpFields(d, dhcp, isIPv6); err != nil {
+		return err
+	}
+	if !isIPv6 {
+		networkView := obj.NetviewName
+		if networkView == "" {
+			networkView = defaultNetView
+		}
+		if err = setNetworkDhcpFailoverField(d, m.(ibclient.IBConnector), networkView, obj.Cidr); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
 }
 
 func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
-	var updateSuccessful bool
+	var updateSuccessful, cidrResized bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
 		// otherwise Terraform will keep the values, which leaded to the failure,
 		// in the state file.
 		if !updateSuccessful {
-			d.Partial(true)
+			// Once the network is resized, its new CIDR and reference are kept in the state;
+			// the partial mode, which keeps the whole previous state, is not used then.
+			if !cidrResized {
+				d.Partial(true)
+			}
 
 			prevNetView, _ := d.GetChange("network_view")
 			prevCIDR, _ := d.GetChange("cidr")
@@ -382,10 +680,13 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 			prevResIPv4, _ := d.GetChange("reserve_ip")
 			prevResIPv6, _ := d.GetChange("reserve_ipv6")
 			prevComment, _ := d.GetChange("comment")
+			prevTemplate, _ := d.GetChange("template")
 			prevEa, _ := d.GetChange("ext_attrs")
 
 			_ = d.Set("network_view", prevNetView.(string))
-			_ = d.Set("cidr", prevCIDR.(string))
+			if !cidrResized {
+				_ = d.Set("cidr", prevCIDR.(string))
+			}
 			_ = d.Set("parent_cidr", prevParCIDR.(string))
 			_ = d.Set("gateway", prevGW.(string))
 			_ = d.Set("allocate_prefix_len", prevPrefLen.(int))
@@ -394,7 +695,12 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 			_ = d.Set("reserve_ip", prevResIPv4.(int))
 			_ = d.Set("reserve_ipv6", prevResIPv6.(int))
 			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("template", prevTemplate.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			for _, field := range networkDhcpFields {
+				prev, _ := d.GetChange(field)
+				_ = d.Set(field, prev)
+			}
 		}
 	}()
 
@@ -404,24 +710,15 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 	if d.HasChange("network_view") {
 		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
 	}
-	if d.HasChange("cidr") {
-		return fmt.Errorf("changing the value of 'cidr' field is not allowed")
-	}
-	if d.HasChange("reserve_ip") {
-		return fmt.Errorf("changing the value of 'reserve_ip' field is not allowed")
-	}
-	if d.HasChange("reserve_ipv6") {
-		return fmt.Errorf("changing the value of 'reserve_ipv6' field is not allowed")
-	}
-	if d.HasChange("gateway") {
-		return fmt.Errorf("changing the value of 'gateway' field is not allowed")
-	}
 	if d.HasChange("filter_params") {
 		return fmt.Errorf("changing the value of 'filter_params' field is not allowed")
 	}
 	if d.HasChange("object") {
 		return fmt.Errorf("changing the value of 'object' field is not allowed")
 	}
+	if d.HasChange("template") {
+		return fmt.Errorf("changing the value of 'template' field is not allowed")
+	}
 
 	networkViewName := d.Get("network_view").(string)
 	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
@@ -447,7 +744,6 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
-	var Network *ibclient.Network
 
 	comment := ""
 	commentVal, commentFieldFound := d.GetOk("comment")
@@ -455,11 +751,38 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 		comment = commentVal.(string)
 	}
 
+	// The network is resized first, the other changes are applied to the resized network.
+	if d.HasChange("cidr") {
+		oldCidr, newCidr := d.GetChange("cidr")
+		ref, err := resizeNetwork(
+			connector, d.Id(), networkViewName, oldCidr.(string), normalizeIPAddress(newCidr), false)
+		if err != nil {
+			return err
+		}
+		cidrResized = true
+		d.SetId(ref)
+		if err = d.Set("ref", ref); err != nil {
+			return err
+		}
+	}
+
 	net, err := objMgr.GetNetworkByRef(d.Id())
 	if err != nil {
 		return fmt.Errorf("failed to read network for update operation: %w", err)
 	}
 
+	isIPv6 := strings.HasPrefix(net.Ref, "ipv6network/")
+	reserveField := "reserve_ip"
+	if isIPv6 {
+		reserveField = "reserve_ipv6"
+		if reserveIPv6 := d.Get("reserve_ipv6").(int); reserveIPv6 > 255 || reserveIPv6 < 0 {
+			return fmt.Errorf("reserve_ipv6 value must be in range 0..255")
+		}
+	}
+	if err = checkNetworkDhcpFields(d, isIPv6); err != nil {
+		return err
+	}
+
 	newExtAttrs, err = mergeEAs(net.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
 		return err
@@ -474,16 +797,58 @@ func resourceNetworkUpdate(d *schema.ResourceData, m interface{}) (err error) {
 
 	newInternalId := newInternalResourceIdFromString(internalId)
 	newExtAttrs[eaNameForInternalId] = newInternalId.String()
-	Network, err = objMgr.UpdateNetwork(net.Ref, newExtAttrs, comment)
+
+	// The network is updated along with its gateway's fixed address, the reserved IP addresses
+	// and the DHCP settings in a single multiple-object request, to have either all of them changed or none.
+	networkData := networkDhcpUpdateData(d, isIPv6, false)
+	networkData["extattrs"] = newExtAttrs
+	networkData["comment"] = comment
+	var reservationItems []wapiRequestItem
+	if d.HasChanges("gateway", reserveField) {
+		oldGateway, newGateway := d.GetChange("gateway")
+		oldReserveCount, newReserveCount := d.GetChange(reserveField)
+		reservationItems, err = networkReservationItems(
+			connector, net.NetviewName, net.Cidr, isIPv6,
+			oldGateway.(string), newGateway.(string), oldReserveCount.(int), newReserveCount.(int))
+		if err != nil {
+			return err
+		}
+	}
+
+	// DHCPv6 has no option for the gateway, it is only reserved then.
+	if !isIPv6 && d.HasChanges("gateway", "dhcp_options") {
+		dhcp, err := getNetworkDhcpData(connector, net.Ref, false)
+		if err != nil {
+			return err
+		}
+		networkData["options"] = networkDhcpOptions(dhcp.Options, d, d.Get("gateway").(string))
+	}
+
+	var failoverItems []wapiRequestItem
+	if !isIPv6 && d.HasChanges("dhcp_failover_association", "cidr") {
+		failoverItems, err = networkDhcpFailoverItems(d, connector, net.NetviewName, net.Cidr)
+		if err != nil {
+			return err
+		}
+	}
+
+	items := append([]wapiRequestItem{{Method: "PUT", Object: net.Ref, Data: networkData}}, reservationItems...)
+	items = append(items, failoverItems...)
+	results, err := multiRequest(connector, items)
 	if err != nil {
 		return fmt.Errorf("Updation of IP Network under network view '%s' failed: '%s'", networkViewName, err.Error())
 	}
+	var newRef string
+	if err = json.Unmarshal(results[0], &newRef); err != nil {
+		return fmt.Errorf("failed to parse the reference of the updated network: %w", err)
+	}
+
 	updateSuccessful = true
-	d.SetId(Network.Ref)
+	d.SetId(newRef)
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
 		return err
 	}
-	if err = d.Set("ref", Network.Ref); err != nil {
+	if err = d.Set("ref", newRef); err != nil {
 		return err
 	}
 
@@ -26,7 +26,7 @@ func resourceNetworkContainer() *schema.Resource {
 					return err
 				}
 			}
-			return nil
+			return networkCidrCustomizeDiff(d)
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -138,7 +138,10 @@ func resourceNetworkContainerCreate(d *schema.ResourceData, m interface{}, isIPv
 				"allocation of network block within network container '%s' under network view '%s' failed: %w", parentCidr, nvName, err)
 		}
 
-		nc, err = objMgr.AllocateNetworkContainer(nvName, parentCidr, isIPv6, uint(prefixLen), comment, extAttrs)
+		err = withAllocationLock(m, nvName, func() (err error) {
+			nc, err = objMgr.AllocateNetworkContainer(nvName, parentCidr, isIPv6, uint(prefixLen), comment, extAttrs)
+			return
+		})
 		if err != nil {
 			return fmt.Errorf("allocation of network block in network view '%s' failed: %w", nvName, err)
 		}
@@ -146,14 +149,15 @@ func resourceNetworkContainerCreate(d *schema.ResourceData, m interface{}, isIPv
 			return err
 		}
 	} else if cidr == "" && nextAvailableFilter != "" && prefixLen > 1 {
-		var eaMap map[string]string
-		err = json.Unmarshal([]byte(nextAvailableFilter), &eaMap)
-		eaMap["network_view"] = nvName
+		eaMap, err := parseNextAvailableFilter(nextAvailableFilter, nvName)
 		if err != nil {
 			return fmt.Errorf("error unmarshalling extra attributes of network container: %s", err)
 		}
 
-		nc, err = objMgr.AllocateNetworkContainerByEA(nvName, isIPv6, comment, extAttrs, eaMap, uint(prefixLen))
+		err = withAllocationLock(m, nvName, func() (err error) {
+			nc, err = objMgr.AllocateNetworkContainerByEA(nvName, isIPv6, comment, extAttrs, eaMap, uint(prefixLen))
+			return
+		})
 		if err != nil {
 			return fmt.Errorf("allocation of network block failed in network with extra attributes (%s) : %s", nextAvailableFilter, err)
 		}
@@ -250,7 +254,7 @@ func resourceNetworkContainerRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error {
-	var updateSuccessful bool
+	var updateSuccessful, cidrResized bool
 	defer func() {
 		// Reverting the state back, in case of a failure,
 		// otherwise Terraform will keep the values, which leaded to the failure,
@@ -265,7 +269,10 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 			prevEa, _ := d.GetChange("ext_attrs")
 
 			_ = d.Set("network_view", prevNetView.(string))
-			_ = d.Set("cidr", prevCIDR.(string))
+			// Once the network container is resized, its new CIDR is kept in the state.
+			if !cidrResized {
+				_ = d.Set("cidr", prevCIDR.(string))
+			}
 			_ = d.Set("parent_cidr", prevParCIDR.(string))
 			_ = d.Set("allocate_prefix_len", prevPrefLen.(int))
 			_ = d.Set("filter_params", prevNextAvailableFilter.(string))
@@ -281,10 +288,6 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("changing the value of 'network_view' field is not allowed")
 	}
 
-	if d.HasChange("cidr") {
-		return fmt.Errorf("changing the value of 'cidr' field is not allowed")
-	}
-
 	if d.HasChange("parent_cidr") {
 		return fmt.Errorf("changing the value of 'parent_cidr' field is not allowed")
 	}
@@ -336,6 +339,20 @@ func resourceNetworkContainerUpdate(d *schema.ResourceData, m interface{}) error
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
+	// The network container is resized first, the other changes are applied to the resized one.
+	if d.HasChange("cidr") {
+		oldCidr, newCidr := d.GetChange("cidr")
+		ref, err := resizeNetwork(connector, d.Id(), nvName, oldCidr.(string), normalizeIPAddress(newCidr), true)
+		if err != nil {
+			return err
+		}
+		cidrResized = true
+		d.SetId(ref)
+		if err = d.Set("ref", ref); err != nil {
+			return err
+		}
+	}
+
 	nc, err := objMgr.GetNetworkContainerByRef(d.Id())
 	if err != nil {
 		return fmt.Errorf("failed to read network container for update operation: %w", err)
@@ -342,6 +342,65 @@ func TestAcc_resourceNetworkContainer_ipv4(t *testing.T) {
 	})
 }
 
+func TestAcc_resourceNetworkContainer_resize(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network_container" "resized" {
+					  cidr = "10.32.0.0/24"
+					  comment = "network container to resize"
+					}`,
+				Check: resource.TestCheckResourceAttr("infoblox_ipv4_network_container.resized", "cidr", "10.32.0.0/24"),
+			},
+			{
+				// expansion
+				Config: `
+					resource "infoblox_ipv4_network_container" "resized" {
+					  cidr = "10.32.0.0/22"
+					  comment = "network container to resize"
+					}`,
+				Check: validateNetworkContainer(
+					"infoblox_ipv4_network_container.resized",
+					&ibclient.NetworkContainer{
+						NetviewName: "default",
+						Cidr:        "10.32.0.0/22",
+						Comment:     "network container to resize",
+					},
+				),
+			},
+			{
+				// shrink
+				Config: `
+					resource "infoblox_ipv4_network_container" "resized" {
+					  cidr = "10.32.2.0/23"
+					  comment = "network container to resize"
+					}`,
+				Check: validateNetworkContainer(
+					"infoblox_ipv4_network_container.resized",
+					&ibclient.NetworkContainer{
+						NetviewName: "default",
+						Cidr:        "10.32.2.0/23",
+						Comment:     "network container to resize",
+					},
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_network_container" "resized" {
+					  cidr = "10.33.0.0/23"
+					  comment = "network container to resize"
+					}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("changing the value of 'cidr' field is not allowed from '10.32.2.0/23' to '10.33.0.0/23'"),
+			},
+		},
+	})
+}
+
 func TestAcc_resourceNetworkContainer_ipv4_ea_inheritance(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -0,0 +1,100 @@
+package infoblox
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkTemplate(isIPv6 bool) *schema.Resource {
+	objType, prefixLenField, maxPrefixLen := "networktemplate", "netmask", 32
+	if isIPv6 {
+		objType, prefixLenField, maxPrefixLen = "ipv6networktemplate", "cidr", 128
+	}
+
+	t := &dhcpTemplate{
+		objType: objType,
+		title:   "network template",
+		fields: map[string]*schema.Schema{
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, maxPrefixLen),
+				Description:  "The prefix length of the networks created out of the template; required unless 'allow_any_netmask' is true.",
+			},
+			"allow_any_netmask": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether networks of any prefix length may be created out of the template.",
+			},
+			"range_templates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the DHCP range templates of the networks created out of the template.",
+			},
+			"fixed_address_templates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the fixed address templates of the networks created out of the template.",
+			},
+			"dhcp_members": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the Grid members which serve DHCP for the networks created out of the template.",
+			},
+		},
+		returnFields: []string{prefixLenField, "allow_any_netmask", "range_templates", "fixed_address_templates", "members"},
+	}
+
+	t.formData = func(d *schema.ResourceData) (map[string]interface{}, error) {
+		prefixLen := d.Get("prefix_length").(int)
+		allowAnyNetmask := d.Get("allow_any_netmask").(bool)
+		if prefixLen == 0 && !allowAnyNetmask {
+			return nil, fmt.Errorf("'prefix_length' field is required unless 'allow_any_netmask' is true")
+		}
+
+		data := map[string]interface{}{
+			"allow_any_netmask":       allowAnyNetmask,
+			"range_templates":         convertStringList(d.Get("range_templates")),
+			"fixed_address_templates": convertStringList(d.Get("fixed_address_templates")),
+			"members":                 convertDhcpMembersFromInterface(d.Get("dhcp_members").([]interface{})),
+		}
+		if prefixLen != 0 {
+			data[prefixLenField] = prefixLen
+		}
+
+		return data, nil
+	}
+
+	t.setFields = func(d *schema.ResourceData, rec map[string]interface{}) error {
+		if err := d.Set("prefix_length", wapiInt(rec, prefixLenField)); err != nil {
+			return err
+		}
+		if err := d.Set("allow_any_netmask", wapiBool(rec, "allow_any_netmask")); err != nil {
+			return err
+		}
+		if err := d.Set("range_templates", rec["range_templates"]); err != nil {
+			return err
+		}
+		if err := d.Set("fixed_address_templates", rec["fixed_address_templates"]); err != nil {
+			return err
+		}
+
+		return d.Set("dhcp_members", convertDhcpMembersToInterface(rec["members"]))
+	}
+
+	return t.resource()
+}
+
+func resourceIPv4NetworkTemplate() *schema.Resource {
+	return resourceNetworkTemplate(false)
+}
+
+func resourceIPv6NetworkTemplate() *schema.Resource {
+	return resourceNetworkTemplate(true)
+}
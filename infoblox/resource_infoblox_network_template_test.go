@@ -0,0 +1,177 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+var dhcpTemplateObjTypes = map[string]string{
+	"infoblox_ipv4_network_template":       "networktemplate",
+	"infoblox_ipv6_network_template":       "ipv6networktemplate",
+	"infoblox_ipv4_range_template":         "rangetemplate",
+	"infoblox_ipv4_fixed_address_template": "fixedaddresstemplate",
+}
+
+func testAccCheckDhcpTemplateDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		objType, ok := dhcpTemplateObjTypes[rs.Type]
+		if !ok {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var rec map[string]interface{}
+		err := connector.GetObject(newWapiObject(objType, []string{"name"}), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &rec)
+		if err == nil && wapiString(rec, "_ref") != "" {
+			return fmt.Errorf("%s still exists", rs.Type)
+		}
+	}
+	return nil
+}
+
+func TestAccResourceNetworkTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDhcpTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_range_template" "dynamic" {
+						name = "tf-acc-branch-dynamic"
+						offset = 100
+						number_of_addresses = 100
+						options {
+							name = "dhcp-lease-time"
+							value = "3600"
+						}
+					}
+
+					resource "infoblox_ipv4_fixed_address_template" "printers" {
+						name = "tf-acc-branch-printers"
+						offset = 10
+						number_of_addresses = 5
+					}
+
+					resource "infoblox_ipv4_network_template" "branch" {
+						name = "tf-acc-branch"
+						comment = "standard branch subnet"
+						prefix_length = 24
+						range_templates = [infoblox_ipv4_range_template.dynamic.name]
+						fixed_address_templates = [infoblox_ipv4_fixed_address_template.printers.name]
+						options {
+							name = "domain-name"
+							value = "branch.test.com"
+						}
+						ext_attrs = jsonencode({
+							"Site" = "Branch"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv4_range_template.dynamic", "server_association_type", "NONE"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_range_template.dynamic", "options.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_fixed_address_template.printers", "number_of_addresses", "5"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network_template.branch", "prefix_length", "24"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network_template.branch", "range_templates.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network_template.branch", "fixed_address_templates.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network_template.branch", "options.0.value", "branch.test.com"),
+					resource.TestCheckResourceAttrSet("infoblox_ipv4_network_template.branch", "internal_id"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_network_template" "branch" {
+						name = "tf-acc-branch"
+						allow_any_netmask = true
+					}
+
+					resource "infoblox_ipv6_network_template" "branch6" {
+						name = "tf-acc-branch6"
+						prefix_length = 64
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv4_network_template.branch", "prefix_length", "0"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network_template.branch", "comment", ""),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network_template.branch", "range_templates.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network_template.branch", "options.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_network_template.branch6", "prefix_length", "64"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_network_template" "branch" {
+						name = "tf-acc-branch"
+					}`,
+				ExpectError: regexp.MustCompile("'prefix_length' field is required"),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_range_template" "dynamic" {
+						name = "tf-acc-branch-dynamic"
+						offset = 100
+						number_of_addresses = 100
+						server_association_type = "MEMBER"
+					}`,
+				ExpectError: regexp.MustCompile("'member' field must be set"),
+			},
+		},
+	})
+}
+
+var testAccNetworkTemplatesConfig = `
+					resource "infoblox_ipv4_fixed_address_template" "printers" {
+						name = "tf-acc-net-printers"
+						offset = 10
+						number_of_addresses = 5
+					}
+
+					resource "infoblox_ipv4_network_template" "branch" {
+						name = "tf-acc-net-branch"
+						prefix_length = 24
+						fixed_address_templates = [infoblox_ipv4_fixed_address_template.printers.name]
+						options {
+							name = "domain-name"
+							value = "branch.test.com"
+						}
+					}
+`
+
+func TestAcc_resourceNetwork_template(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkTemplatesConfig + `
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.50.0.0/24"
+						template = infoblox_ipv4_network_template.branch.name
+						gateway = "10.50.0.1"
+						reserve_ip = 2
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "template", "tf-acc-net-branch"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "gateway", "10.50.0.1"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "dhcp_options.#", "0"),
+				),
+			},
+			{
+				Config: testAccNetworkTemplatesConfig + `
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.50.0.0/24"
+						template = "tf-acc-other"
+						gateway = "10.50.0.1"
+						reserve_ip = 2
+					}`,
+				ExpectError: regexp.MustCompile("changing the value of 'template' field is not allowed"),
+			},
+		},
+	})
+}
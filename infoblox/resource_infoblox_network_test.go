@@ -187,26 +187,12 @@ func TestAcc_resourceNetwork_ipv4(t *testing.T) {
 							"Site" = "Test site"
 						})
 					}`,
-				ExpectError: updateNotAllowedErrorRegexp,
-			},
-			{
-				Config: `
-					resource "infoblox_ipv4_network" "foo"{
-						network_view="default"
-						cidr="10.10.0.0/24"
-						reserve_ip = 6
-						gateway = "10.10.0.250"
-						comment = "10.0.0.0/24 network created"
-						ext_attrs = jsonencode({
-							"Network Name"= "demo-network"
-							"Tenant ID" = "terraform_test_tenant"
-							"Location" = "Test loc."
-							"Site" = "Test site"
-						})
-					}`,
-				// double-check that the next update (with the same changes) returns an error as well
-				// (in case the field to be updated is 'computed' and the main code do not clear it to the previous state)
-				ExpectError: updateNotAllowedErrorRegexp,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.foo", "reserve_ip", "6"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.foo", "gateway", "10.10.0.250"),
+					// 6 reserved IP addresses and the gateway's one
+					testAccCheckNetworkReservations("infoblox_ipv4_network.foo", false, 7),
+				),
 			},
 			{
 				Config: `
@@ -223,7 +209,12 @@ func TestAcc_resourceNetwork_ipv4(t *testing.T) {
 							"Site" = "Test site"
 						})
 					}`,
-				ExpectError: updateNotAllowedErrorRegexp,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.foo", "reserve_ip", "5"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.foo", "gateway", "10.10.0.251"),
+					testAccCheckNetworkReservations("infoblox_ipv4_network.foo", false, 6),
+					testAccCheckNetworkRouters("infoblox_ipv4_network.foo", "10.10.0.251"),
+				),
 			},
 			{
 				Config: `
@@ -231,7 +222,7 @@ func TestAcc_resourceNetwork_ipv4(t *testing.T) {
 						network_view="default"
 						cidr="10.10.0.0/24"
 						reserve_ip = 5
-						gateway = "10.10.0.251"
+						gateway = "none"
 						comment = "10.0.0.0/24 network created"
 						ext_attrs = jsonencode({
 							"Network Name"= "demo-network"
@@ -240,7 +231,11 @@ func TestAcc_resourceNetwork_ipv4(t *testing.T) {
 							"Site" = "Test site"
 						})
 					}`,
-				ExpectError: updateNotAllowedErrorRegexp,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.foo", "gateway", "none"),
+					testAccCheckNetworkReservations("infoblox_ipv4_network.foo", false, 5),
+					testAccCheckNetworkRouters("infoblox_ipv4_network.foo", ""),
+				),
 			},
 		},
 	})
@@ -474,6 +469,122 @@ func TestAcc_resourceNetwork_ipv4_ea_inheritance(t *testing.T) {
 	})
 }
 
+func TestAcc_resourceNetwork_resize(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "resized"{
+						network_view = "default"
+						cidr = "10.30.0.0/24"
+						comment = "network to resize"
+						ext_attrs = jsonencode({
+							"Tenant ID" = "terraform_test_tenant"
+						})
+					}`,
+				Check: resource.TestCheckResourceAttr("infoblox_ipv4_network.resized", "cidr", "10.30.0.0/24"),
+			},
+			{
+				// expansion
+				Config: `
+					resource "infoblox_ipv4_network" "resized"{
+						network_view = "default"
+						cidr = "10.30.0.0/23"
+						comment = "network to resize"
+						ext_attrs = jsonencode({
+							"Tenant ID" = "terraform_test_tenant"
+						})
+					}`,
+				Check: validateNetwork(
+					"infoblox_ipv4_network.resized",
+					&ibclient.Network{
+						NetviewName: "default",
+						Cidr:        "10.30.0.0/23",
+						Comment:     "network to resize",
+						Ea: ibclient.EA{
+							"Tenant ID": "terraform_test_tenant",
+						},
+					},
+				),
+			},
+			{
+				// split
+				Config: `
+					resource "infoblox_ipv4_network" "resized"{
+						network_view = "default"
+						cidr = "10.30.1.0/25"
+						comment = "network to resize"
+						ext_attrs = jsonencode({
+							"Tenant ID" = "terraform_test_tenant"
+						})
+					}`,
+				Check: validateNetwork(
+					"infoblox_ipv4_network.resized",
+					&ibclient.Network{
+						NetviewName: "default",
+						Cidr:        "10.30.1.0/25",
+						Comment:     "network to resize",
+						Ea: ibclient.EA{
+							"Tenant ID": "terraform_test_tenant",
+						},
+					},
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "resized"{
+						network_view = "default"
+						cidr = "10.31.0.0/24"
+						comment = "network to resize"
+						ext_attrs = jsonencode({
+							"Tenant ID" = "terraform_test_tenant"
+						})
+					}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("changing the value of 'cidr' field is not allowed from '10.30.1.0/25' to '10.31.0.0/24'"),
+			},
+		},
+	})
+}
+
+func TestAcc_resourceNetwork_resize_join(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "joining" {
+						cidr = "10.34.0.0/24"
+					}`,
+				Check: resource.TestCheckResourceAttr("infoblox_ipv4_network.joining", "joined_networks.#", "0"),
+			},
+			{
+				// the network created out of Terraform is joined into the expanded one
+				PreConfig: func() {
+					objMgr := ibclient.NewObjectManager(testAccProvider.Meta().(ibclient.IBConnector), "Terraform", "")
+					if _, err := objMgr.CreateNetwork("default", "10.34.1.0/24", false, "", nil); err != nil {
+						t.Fatalf("failed to create network 10.34.1.0/24: %s", err)
+					}
+				},
+				Config: `
+					resource "infoblox_ipv4_network" "joining" {
+						cidr = "10.34.0.0/23"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.joining", "cidr", "10.34.0.0/23"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.joining", "joined_networks.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.joining", "joined_networks.0", "10.34.1.0/24"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_resourceNetwork_ipv6(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -523,14 +634,17 @@ func TestAcc_resourceNetwork_ipv6(t *testing.T) {
 							"Site" = "Test site"
 						})
 					}`,
-				ExpectError: updateNotAllowedErrorRegexp,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv6_network.foo", "reserve_ipv6", "11"),
+					testAccCheckNetworkReservations("infoblox_ipv6_network.foo", true, 11),
+				),
 			},
 			{
 				Config: `
 					resource "infoblox_ipv6_network" "foo"{
 						network_view="default"
 						cidr="2001:db8:abcd:12::/64"
-						reserve_ipv6 = 11
+						reserve_ipv6 = 3
 						comment = "2001:db8:abcd:12::/64 network created"
 						ext_attrs = jsonencode({
 							"Tenant ID" = "terraform_test_tenant"
@@ -539,7 +653,10 @@ func TestAcc_resourceNetwork_ipv6(t *testing.T) {
 							"Site" = "Test site"
 						})
 					}`,
-				ExpectError: updateNotAllowedErrorRegexp,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv6_network.foo", "reserve_ipv6", "3"),
+					testAccCheckNetworkReservations("infoblox_ipv6_network.foo", true, 3),
+				),
 			},
 		},
 	})
@@ -606,3 +723,166 @@ func TestAcc_resourceNetwork_AllocateNetworkByEA_IPV4(t *testing.T) {
 		},
 	})
 }
+
+// testAccCheckNetworkReservations checks the number of fixed addresses of the network
+// which reserve its IP addresses, including the gateway's one.
+func testAccCheckNetworkReservations(resPath string, isIPv6 bool, expectedCount int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+
+		sf := map[string]string{
+			"network_view": res.Primary.Attributes["network_view"],
+			"network":      res.Primary.Attributes["cidr"],
+		}
+		if !isIPv6 {
+			sf["mac"] = ibclient.MACADDR_ZERO
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		var fixedAddrs []ibclient.FixedAddress
+		err := connector.GetObject(ibclient.NewEmptyFixedAddress(isIPv6), "", ibclient.NewQueryParams(false, sf), &fixedAddrs)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+
+		count := 0
+		for _, fa := range fixedAddrs {
+			if !isIPv6 || fa.Duid == ibclient.MACADDR_ZERO || isReservationDuid(fa.Duid) {
+				count++
+			}
+		}
+		if count != expectedCount {
+			return fmt.Errorf("expected %d reserved IP addresses in network '%s', got %d", expectedCount, sf["network"], count)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckNetworkRouters checks the value of DHCP 'routers' option of the IPv4 network,
+// an empty value means the network must not have the option.
+func testAccCheckNetworkRouters(resPath string, expectedRouters string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+
+		nw := &ibclient.Ipv4Network{}
+		nw.SetReturnFields([]string{"options"})
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		if err := connector.GetObject(nw, res.Primary.ID, ibclient.NewQueryParams(false, nil), nw); err != nil {
+			return err
+		}
+
+		routers := ""
+		for _, opt := range nw.Options {
+			if opt != nil && opt.Name == "routers" {
+				routers = opt.Value
+			}
+		}
+		if routers != expectedRouters {
+			return fmt.Errorf("expected DHCP 'routers' option of the network to be '%s', got '%s'", expectedRouters, routers)
+		}
+
+		return nil
+	}
+}
+
+func TestAcc_resourceNetwork_dhcp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.47.0.0/24"
+						gateway = "10.47.0.1"
+						dhcp_options {
+							domain_name_servers = ["10.47.0.2", "10.47.0.3"]
+							domain_name = "dhcp-test.com"
+							lease_time = 3600
+						}
+						ddns {
+							enable = true
+							domain_name = "ddns-test.com"
+						}
+						pxe {
+							bootfile = "pxelinux.0"
+							nextserver = "10.47.0.4"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "dhcp_options.0.domain_name_servers.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "dhcp_options.0.domain_name", "dhcp-test.com"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "dhcp_options.0.lease_time", "3600"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "dhcp_options.0.routers.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "ddns.0.enable", "true"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "ddns.0.domain_name", "ddns-test.com"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "ddns.0.ttl", "0"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "pxe.0.bootfile", "pxelinux.0"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "pxe.0.bootserver", ""),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "dhcp_failover_association", ""),
+					testAccCheckNetworkRouters("infoblox_ipv4_network.net", "10.47.0.1"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv4_network" "net" {
+						cidr = "10.47.0.0/24"
+						gateway = "10.47.0.1"
+						dhcp_options {
+							routers = ["10.47.0.254"]
+							domain_name = "dhcp-test.com"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "dhcp_options.0.routers.0", "10.47.0.254"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "dhcp_options.0.domain_name_servers.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "dhcp_options.0.lease_time", "0"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "ddns.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_ipv4_network.net", "pxe.#", "0"),
+					testAccCheckNetworkRouters("infoblox_ipv4_network.net", "10.47.0.254"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net6" {
+						cidr = "2001:db8:47::/64"
+						dhcp_options {
+							routers = ["2001:db8:47::1"]
+						}
+					}`,
+				ExpectError: regexp.MustCompile("'routers' DHCP option is not supported by IPv6 networks"),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net6" {
+						cidr = "2001:db8:47::/64"
+						dhcp_failover_association = "failover-test"
+					}`,
+				ExpectError: regexp.MustCompile("'dhcp_failover_association' is not supported by IPv6 networks"),
+			},
+			{
+				Config: `
+					resource "infoblox_ipv6_network" "net6" {
+						cidr = "2001:db8:47::/64"
+						dhcp_options {
+							domain_name_servers = ["2001:db8:47::53"]
+							lease_time = 7200
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_ipv6_network.net6", "dhcp_options.0.domain_name_servers.0", "2001:db8:47::53"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_network.net6", "dhcp_options.0.lease_time", "7200"),
+					resource.TestCheckResourceAttr("infoblox_ipv6_network.net6", "dhcp_options.0.domain_name", ""),
+				),
+			},
+		},
+	})
+}
@@ -25,6 +25,9 @@ func resourcePTRRecord() *schema.Resource {
 					return err
 				}
 			}
+			if err := dnsNameFormsCustomizeDiff(d, "ptrdname"); err != nil {
+				return err
+			}
 			return nil
 		},
 
@@ -40,11 +43,15 @@ func resourcePTRRecord() *schema.Resource {
 				Optional:    true,
 				Description: "The network address in cidr format under which record has to be created.",
 			},
+			"range_start":         rangeStartSchema,
+			"range_end":           rangeEndSchema,
+			"range_filter_params": rangeFilterParamsSchema,
+			"exclude":             excludeSchema,
 			"ip_addr": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Optional:    true,
-				Description: "IPv4/IPv6 address for record creation. Set the field with valid IP for static allocation. If to be dynamically allocated set cidr field",
+				Description: "IPv4/IPv6 address for record creation. Set the field with valid IP for static allocation. If to be dynamically allocated set cidr field, or 'range_start' and 'range_end' or 'range_filter_params' fields to allocate it from a DHCP range",
 			},
 			"dns_view": {
 				Type:        schema.TypeString,
@@ -53,15 +60,19 @@ func resourcePTRRecord() *schema.Resource {
 				Description: "Dns View under which the zone has been created.",
 			},
 			"ptrdname": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The domain name in FQDN to which the record should point to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The domain name in FQDN to which the record should point to.",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
+			"ptrdname_ascii":   dnsNameAsciiSchema("ptrdname"),
+			"ptrdname_unicode": dnsNameUnicodeSchema("ptrdname"),
 			"record_name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "The name of the DNS PTR record in FQDN format",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				Description:      "The name of the DNS PTR record in FQDN format",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
@@ -134,12 +145,12 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "dns_view")
 	}
 
-	ptrdname, trimmed := checkAndTrimSpaces(d.Get("ptrdname").(string))
+	ptrdname, trimmed := checkAndTrimSpaces(dnsNameForWapi(d.Get("ptrdname").(string)))
 	if trimmed {
 		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "ptrdname")
 	}
 
-	recordName, trimmed := checkAndTrimSpaces(d.Get("record_name").(string))
+	recordName, trimmed := checkAndTrimSpaces(dnsNameForWapi(d.Get("record_name").(string)))
 	if trimmed {
 		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "record_name")
 	}
@@ -147,6 +158,15 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 		ipAddrSrcCounter = ipAddrSrcCounter + 1
 	}
 
+	rng, err := getRangeAllocation(d)
+	if err != nil {
+		return err
+	}
+	if rng != nil && ipAddrSrcCounter != 0 {
+		return fmt.Errorf(
+			"the DHCP range to allocate the IP address from is not allowed to be defined along with 'ip_addr', 'cidr' or 'record_name'")
+	}
+
 	comment := d.Get("comment").(string)
 	extAttrJSON := d.Get("ext_attrs").(string)
 	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
@@ -163,13 +183,13 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 		tenantID = tempVal.(string)
 	}
 
-	if ipAddrSrcCounter == 0 {
+	if ipAddrSrcCounter == 0 && rng == nil {
 		return fmt.Errorf(
 			"'ip_addr' or 'cidr' are mandatory in reverse mapping zone " +
 				"and 'record_name' is mandatory in forward mapping zone")
 	}
 
-	if ipAddrSrcCounter != 1 {
+	if ipAddrSrcCounter > 1 {
 		return fmt.Errorf(
 			"only one of 'ip_addr', 'cidr' and 'record_name' must be defined")
 	}
@@ -188,17 +208,30 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 	connector := m.(ibclient.IBConnector)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
-	recordPTR, err := objMgr.CreatePTRRecord(
-		networkView,
-		dnsViewName,
-		ptrdname,
-		recordName,
-		cidr,
-		ipAddr,
-		useTtl,
-		ttl,
-		comment,
-		extAttrs)
+	var recordPTR *ibclient.RecordPTR
+	create := func() (err error) {
+		recordPTR, err = objMgr.CreatePTRRecord(
+			networkView,
+			dnsViewName,
+			ptrdname,
+			recordName,
+			cidr,
+			ipAddr,
+			useTtl,
+			ttl,
+			comment,
+			extAttrs)
+		return
+	}
+	// The next available IP address of the DHCP range or of the network is allocated if either is specified.
+	if rng != nil {
+		recordPTR, err = createPTRRecordInRange(
+			m, objMgr, rng, networkView, dnsViewName, ptrdname, useTtl, ttl, comment, extAttrs)
+	} else if cidr != "" {
+		err = withAllocationLock(m, networkView, create)
+	} else {
+		err = create()
+	}
 	if err != nil {
 		return fmt.Errorf("creation of PTR-record under the DNS view '%s' failed: %s", dnsViewName, err)
 	}
@@ -245,6 +278,48 @@ func resourcePTRRecordCreate(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// createPTRRecordInRange creates the PTR record for the next available IP address of the DHCP range.
+func createPTRRecordInRange(
+	m interface{},
+	objMgr ibclient.IBObjectManager,
+	rng *rangeAllocation,
+	networkView string,
+	dnsView string,
+	ptrdname string,
+	useTtl bool,
+	ttl uint32,
+	comment string,
+	eas ibclient.EA) (*ibclient.RecordPTR, error) {
+
+	isIPv6, err := rng.isIPv6(m.(ibclient.IBConnector), networkView)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &ibclient.IpNextAvailableInfo{Function: "next_available_ip", ResultField: "ips"}
+	rng.setNextAvailableIp(info, isIPv6, networkView)
+	rec := &recordPtrNextAvailable{
+		PtrdName: ptrdname,
+		View:     dnsView,
+		UseTtl:   useTtl,
+		Ttl:      ttl,
+		Comment:  comment,
+		Ea:       eas,
+	}
+	if isIPv6 {
+		rec.Ipv6Addr = info
+	} else {
+		rec.Ipv4Addr = info
+	}
+
+	ref, err := allocateInRange(m, networkView, rec)
+	if err != nil {
+		return nil, err
+	}
+
+	return objMgr.GetPTRRecordByRef(ref)
+}
+
 func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 	var ttl int
 	extAttrJSON := d.Get("ext_attrs").(string)
@@ -344,6 +419,10 @@ func resourcePTRRecordGet(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := setDnsNameForms(d, "ptrdname"); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
@@ -375,6 +454,7 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
+			revertRangeAllocationFields(d)
 		}
 	}()
 
@@ -389,15 +469,18 @@ func resourcePTRRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	if d.HasChange("dns_view") {
 		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
 	}
+	if err := checkRangeAllocationUpdate(d); err != nil {
+		return err
+	}
 
 	networkView := d.Get("network_view").(string)
-	ptrdname := d.Get("ptrdname").(string)
+	ptrdname := dnsNameForWapi(d.Get("ptrdname").(string))
 	dnsView := d.Get("dns_view").(string)
 
 	ipAddrSrcChangesCounter := 0
 	ipAddrSrcCounter := 0
 
-	recordName, trimmed := checkAndTrimSpaces(d.Get("record_name").(string))
+	recordName, trimmed := checkAndTrimSpaces(dnsNameForWapi(d.Get("record_name").(string)))
 	if trimmed {
 		return fmt.Errorf(errMsgFormatLeadingTrailingSpaces, "record_name")
 	}
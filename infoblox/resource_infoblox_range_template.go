@@ -0,0 +1,99 @@
+package infoblox
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRangeTemplate() *schema.Resource {
+	t := &dhcpTemplate{
+		objType: "rangetemplate",
+		title:   "DHCP range template",
+		fields: map[string]*schema.Schema{
+			"offset": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The offset, from the network's address, of the start address of the DHCP ranges created out of the template.",
+			},
+			"number_of_addresses": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of the IP addresses of the DHCP ranges created out of the template.",
+			},
+			"server_association_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "MEMBER", "FAILOVER"}, false),
+				Description:  "The type of the DHCP server of the DHCP ranges: NONE, MEMBER ('member' field) or FAILOVER ('failover_association' field).",
+			},
+			"member": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the Grid member which serves the DHCP ranges, when 'server_association_type' is MEMBER.",
+			},
+			"failover_association": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the DHCP failover association which serves the DHCP ranges, when 'server_association_type' is FAILOVER.",
+			},
+		},
+		returnFields: []string{"offset", "number_of_addresses", "server_association_type", "member", "failover_association"},
+	}
+
+	t.formData = func(d *schema.ResourceData) (map[string]interface{}, error) {
+		association := d.Get("server_association_type").(string)
+		member := d.Get("member").(string)
+		failover := d.Get("failover_association").(string)
+		if (association == "MEMBER") != (member != "") {
+			return nil, fmt.Errorf("'member' field must be set if, and only if, 'server_association_type' is MEMBER")
+		}
+		if (association == "FAILOVER") != (failover != "") {
+			return nil, fmt.Errorf("'failover_association' field must be set if, and only if, 'server_association_type' is FAILOVER")
+		}
+
+		data := map[string]interface{}{
+			"offset":                  d.Get("offset").(int),
+			"number_of_addresses":     d.Get("number_of_addresses").(int),
+			"server_association_type": association,
+		}
+		switch association {
+		case "MEMBER":
+			data["member"] = map[string]interface{}{"_struct": "dhcpmember", "name": member}
+		case "FAILOVER":
+			data["failover_association"] = failover
+		}
+
+		return data, nil
+	}
+
+	t.setFields = func(d *schema.ResourceData, rec map[string]interface{}) error {
+		if err := d.Set("offset", wapiInt(rec, "offset")); err != nil {
+			return err
+		}
+		if err := d.Set("number_of_addresses", wapiInt(rec, "number_of_addresses")); err != nil {
+			return err
+		}
+		if err := d.Set("server_association_type", wapiString(rec, "server_association_type")); err != nil {
+			return err
+		}
+
+		var member string
+		if m, ok := rec["member"].(map[string]interface{}); ok {
+			member = wapiString(m, "name")
+		}
+		if err := d.Set("member", member); err != nil {
+			return err
+		}
+
+		return d.Set("failover_association", wapiString(rec, "failover_association"))
+	}
+
+	return t.resource()
+}
@@ -0,0 +1,550 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+const (
+	rpzTriggerQname           = "QNAME"
+	rpzTriggerIpAddress       = "IP_ADDRESS"
+	rpzTriggerClientIpAddress = "CLIENT_IP_ADDRESS"
+
+	rpzActionPassthru      = "PASSTHRU"
+	rpzActionBlockNxdomain = "BLOCK_NXDOMAIN"
+	rpzActionBlockNodata   = "BLOCK_NODATA"
+	rpzActionSubstitute    = "SUBSTITUTE"
+
+	// Special values of the 'canonical' field of 'record:rpz:cname*' objects,
+	// which define the rule's action.
+	rpzCanonicalNxdomain = ""
+	rpzCanonicalNodata   = "*"
+	rpzCanonicalPassthru = "rpz-passthru"
+)
+
+// rpzRuleBase holds the fields which all 'record:rpz:*' WAPI objects have in common.
+type rpzRuleBase struct {
+	Ref     string      `json:"_ref,omitempty"`
+	Name    *string     `json:"name,omitempty"`
+	RpZone  *string     `json:"rp_zone,omitempty"`
+	View    *string     `json:"view,omitempty"`
+	Comment *string     `json:"comment,omitempty"`
+	Disable *bool       `json:"disable,omitempty"`
+	Ttl     *uint32     `json:"ttl,omitempty"`
+	UseTtl  *bool       `json:"use_ttl,omitempty"`
+	Ea      ibclient.EA `json:"extattrs"`
+}
+
+var rpzRuleCommonReturnFields = []string{
+	"name",
+	"rp_zone",
+	"view",
+	"comment",
+	"disable",
+	"ttl",
+	"use_ttl",
+	"extattrs",
+}
+
+// rpzRuleType describes the specifics of a particular kind of RPZ rules,
+// the rest of the resource's logic is common for all of them.
+type rpzRuleType struct {
+	// Human-readable name of the rule type, to be used in messages.
+	title string
+
+	// Type-specific return fields, in addition to rpzRuleCommonReturnFields.
+	returnFields []string
+
+	// Type-specific fields of the resource's schema.
+	fields map[string]*schema.Schema
+
+	// Constructors of empty WAPI objects which may represent the rule, by WAPI object type.
+	objects map[string]func() ibclient.IBObject
+
+	// Returns the WAPI object type which corresponds to the resource's data.
+	objectType func(d *schema.ResourceData) string
+
+	// Returns a WAPI object of the given type, with the common fields taken from 'base'
+	// and type-specific fields taken from the resource's data.
+	form func(d *schema.ResourceData, objType string, base *rpzRuleBase) (ibclient.IBObject, error)
+
+	// Sets type-specific fields of the resource from the WAPI object of the given type.
+	set func(d *schema.ResourceData, objType string, rec map[string]interface{}) error
+}
+
+func (t *rpzRuleType) newEmptyObject(objType string) ibclient.IBObject {
+	obj := t.objects[objType]()
+	obj.SetReturnFields(append(append([]string{}, rpzRuleCommonReturnFields...), t.returnFields...))
+
+	return obj
+}
+
+// currentObjectType returns the type of the WAPI object the resource is currently
+// represented with, taken from the object's reference when it is already known.
+func (t *rpzRuleType) currentObjectType(d *schema.ResourceData) string {
+	ref := d.Get("ref").(string)
+	if ref == "" {
+		ref = d.Id()
+	}
+	if idx := strings.Index(ref, "/"); idx > 0 {
+		if _, ok := t.objects[ref[:idx]]; ok {
+			return ref[:idx]
+		}
+	}
+
+	return t.objectType(d)
+}
+
+// rpzRuleTriggerSchema returns the schema of the 'trigger' field,
+// restricted to the triggers the rule type supports.
+func rpzRuleTriggerSchema(triggers ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      triggers[0],
+		ValidateFunc: validation.StringInSlice(triggers, false),
+		Description: "The kind of the rule's trigger: QNAME for a domain name, IP_ADDRESS for an address" +
+			" in a response or CLIENT_IP_ADDRESS for the address of a client.",
+	}
+}
+
+// RPZ rules are named in WAPI by the trigger followed by the name of the response policy zone.
+func rpzRuleFullName(name string, rpZone string) string {
+	return name + "." + rpZone
+}
+
+func rpzRuleRelativeName(fullName string, rpZone string) string {
+	return strings.TrimSuffix(fullName, "."+rpZone)
+}
+
+func resourceRpzRuleRecord(t *rpzRuleType) *schema.Resource {
+	ruleSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "The trigger of the rule, relative to the response policy zone:" +
+				" a domain name, or an IP address or network for the IP-based triggers.",
+			DiffSuppressFunc: suppressDnsNameDiff,
+		},
+		"rp_zone": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the response policy zone the rule belongs to.",
+		},
+		"view": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     defaultDNSView,
+			Description: "The DNS view in which the response policy zone resides.",
+		},
+		"ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     ttlUndef,
+			Description: "TTL value of the rule.",
+		},
+		"disable": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Determines if the rule is disabled or not.",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Description of the rule.",
+		},
+		"ext_attrs": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Extensible attributes of the rule to be added/updated, as a map in JSON format",
+		},
+		"internal_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "Internal ID of an object at NIOS side," +
+				" used by Infoblox Terraform plugin to search for a NIOS's object" +
+				" which corresponds to the Terraform resource.",
+		},
+		"ref": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "NIOS object's reference, not to be set by a user.",
+		},
+	}
+	for k, v := range t.fields {
+		ruleSchema[k] = v
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return resourceRpzRuleCreate(t, d, m)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return resourceRpzRuleGet(t, d, m)
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			return resourceRpzRuleUpdate(t, d, m)
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return resourceRpzRuleDelete(t, d, m)
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				return resourceRpzRuleImport(t, d, m)
+			},
+		},
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Schema: ruleSchema,
+	}
+}
+
+// formRpzRuleBase gets the fields, common for all RPZ rule types,
+// from the resource's data.
+func formRpzRuleBase(d *schema.ResourceData) (*rpzRuleBase, error) {
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return nil, fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	rpZone := d.Get("rp_zone").(string)
+
+	return &rpzRuleBase{
+		Name:    utils.StringPtr(rpzRuleFullName(dnsNameForWapi(d.Get("name").(string)), rpZone)),
+		Comment: utils.StringPtr(d.Get("comment").(string)),
+		Disable: utils.BoolPtr(d.Get("disable").(bool)),
+		Ttl:     utils.Uint32Ptr(ttl),
+		UseTtl:  utils.BoolPtr(useTtl),
+	}, nil
+}
+
+func setRpzRuleFields(t *rpzRuleType, d *schema.ResourceData, objType string, rec map[string]interface{}) (*rpzRuleBase, error) {
+	var base rpzRuleBase
+	if err := convertWapiObject(rec, &base); err != nil {
+		return nil, err
+	}
+
+	rpZone := ""
+	if base.RpZone != nil {
+		rpZone = *base.RpZone
+	}
+	if err := d.Set("rp_zone", rpZone); err != nil {
+		return nil, err
+	}
+
+	if base.Name != nil {
+		if err := d.Set("name", rpzRuleRelativeName(*base.Name, rpZone)); err != nil {
+			return nil, err
+		}
+	}
+
+	if base.View != nil {
+		if err := d.Set("view", *base.View); err != nil {
+			return nil, err
+		}
+	}
+
+	ttl := ttlUndef
+	if base.UseTtl != nil && *base.UseTtl && base.Ttl != nil {
+		ttl = int(*base.Ttl)
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return nil, err
+	}
+
+	comment := ""
+	if base.Comment != nil {
+		comment = *base.Comment
+	}
+	if err := d.Set("comment", comment); err != nil {
+		return nil, err
+	}
+
+	disable := false
+	if base.Disable != nil {
+		disable = *base.Disable
+	}
+	if err := d.Set("disable", disable); err != nil {
+		return nil, err
+	}
+
+	if err := t.set(d, objType, rec); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("ref", base.Ref); err != nil {
+		return nil, err
+	}
+
+	return &base, nil
+}
+
+func resourceRpzRuleCreate(t *rpzRuleType, d *schema.ResourceData, m interface{}) error {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+	}
+
+	base, err := formRpzRuleBase(d)
+	if err != nil {
+		return err
+	}
+	base.RpZone = utils.StringPtr(d.Get("rp_zone").(string))
+	base.View = utils.StringPtr(d.Get("view").(string))
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	base.Ea = extAttrs
+
+	rec, err := t.form(d, t.objectType(d), base)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(rec)
+	if err != nil {
+		return fmt.Errorf("error creating %s: %s", t.title, err)
+	}
+
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return err
+	}
+
+	return resourceRpzRuleGet(t, d, m)
+}
+
+func resourceRpzRuleGet(t *rpzRuleType, d *schema.ResourceData, m interface{}) error {
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	objType := t.currentObjectType(d)
+	var rec map[string]interface{}
+	err = searchGenericObjectByRefOrInternalId(t.newEmptyObject(objType), d, m, &rec)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	base, err := setRpzRuleFields(t, d, objType, rec)
+	if err != nil {
+		return err
+	}
+
+	delete(base.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(base.Ea, extAttrs)
+
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(base.Ref)
+
+	return nil
+}
+
+func resourceRpzRuleUpdate(t *rpzRuleType, d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			for _, field := range []string{"name", "ttl", "disable", "comment", "ext_attrs"} {
+				prevVal, _ := d.GetChange(field)
+				_ = d.Set(field, prevVal)
+			}
+			for field := range t.fields {
+				prevVal, _ := d.GetChange(field)
+				_ = d.Set(field, prevVal)
+			}
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+	}
+	if d.HasChange("rp_zone") {
+		return fmt.Errorf("changing the value of 'rp_zone' field is not allowed")
+	}
+	if d.HasChange("view") {
+		return fmt.Errorf("changing the value of 'view' field is not allowed")
+	}
+	if _, ok := t.fields["trigger"]; ok && d.HasChange("trigger") {
+		return fmt.Errorf("changing the value of 'trigger' field is not allowed")
+	}
+
+	objType := t.currentObjectType(d)
+	if newObjType := t.objectType(d); newObjType != objType {
+		return fmt.Errorf(
+			"the rule is represented by a '%s' object on NIOS side and cannot be changed in-place"+
+				" to a '%s' object; re-create the resource instead", objType, newObjType)
+	}
+
+	base, err := formRpzRuleBase(d)
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
+
+	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	var currentRec rpzRuleBase
+	err = searchGenericObjectByRefOrInternalId(t.newEmptyObject(objType), d, m, &currentRec)
+	if err != nil {
+		return fmt.Errorf("failed to read %s for update operation: %w", t.title, err)
+	}
+
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	base.Ea, err = mergeEAs(currentRec.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return err
+	}
+
+	rec, err := t.form(d, objType, base)
+	if err != nil {
+		return err
+	}
+
+	ref, err := connector.UpdateObject(rec, currentRec.Ref)
+	if err != nil {
+		return fmt.Errorf("error updating %s: %s", t.title, err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return err
+	}
+
+	return resourceRpzRuleGet(t, d, m)
+}
+
+func resourceRpzRuleDelete(t *rpzRuleType, d *schema.ResourceData, m interface{}) error {
+	var rec rpzRuleBase
+	err := searchGenericObjectByRefOrInternalId(t.newEmptyObject(t.currentObjectType(d)), d, m, &rec)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	connector := m.(ibclient.IBConnector)
+	if _, err = connector.DeleteObject(rec.Ref); err != nil {
+		return fmt.Errorf("deletion of %s failed: %s", t.title, err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceRpzRuleImport(t *rpzRuleType, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(ibclient.IBConnector)
+
+	objType := d.Id()
+	if idx := strings.Index(objType, "/"); idx > 0 {
+		objType = objType[:idx]
+	}
+	if _, ok := t.objects[objType]; !ok {
+		return nil, fmt.Errorf("'%s' is not a reference of %s", d.Id(), t.title)
+	}
+
+	var rec map[string]interface{}
+	if err := connector.GetObject(t.newEmptyObject(objType), d.Id(), ibclient.NewQueryParams(false, nil), &rec); err != nil {
+		return nil, fmt.Errorf("failed getting %s: %s", t.title, err)
+	}
+
+	base, err := setRpzRuleFields(t, d, objType, rec)
+	if err != nil {
+		return nil, err
+	}
+
+	if base.Ea != nil && len(base.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(base.Ea)
+		if err != nil {
+			return nil, err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(base.Ref)
+
+	if err = resourceRpzRuleUpdate(t, d, m); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,67 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+const (
+	rpzAObjType          = "record:rpz:a"
+	rpzAIpAddressObjType = "record:rpz:a:ipaddress"
+)
+
+var rpzRuleTypeA = &rpzRuleType{
+	title:        "RPZ substitute A-record rule",
+	returnFields: []string{"ipv4addr"},
+	fields: map[string]*schema.Schema{
+		"trigger": rpzRuleTriggerSchema(rpzTriggerQname, rpzTriggerIpAddress),
+		"ipv4addr": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPv4Address,
+			Description:  "The IPv4 address to substitute the response with.",
+		},
+	},
+	objects: map[string]func() ibclient.IBObject{
+		rpzAObjType:          func() ibclient.IBObject { return &ibclient.RecordRpzA{} },
+		rpzAIpAddressObjType: func() ibclient.IBObject { return &ibclient.RecordRpzAIpaddress{} },
+	},
+	objectType: func(d *schema.ResourceData) string {
+		if d.Get("trigger").(string) == rpzTriggerIpAddress {
+			return rpzAIpAddressObjType
+		}
+		return rpzAObjType
+	},
+	form: func(d *schema.ResourceData, objType string, base *rpzRuleBase) (ibclient.IBObject, error) {
+		ipv4Addr := utils.StringPtr(d.Get("ipv4addr").(string))
+		if objType == rpzAIpAddressObjType {
+			return &ibclient.RecordRpzAIpaddress{
+				Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+				Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+				Ipv4Addr: ipv4Addr,
+			}, nil
+		}
+		return &ibclient.RecordRpzA{
+			Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+			Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+			Ipv4Addr: ipv4Addr,
+		}, nil
+	},
+	set: func(d *schema.ResourceData, objType string, rec map[string]interface{}) error {
+		trigger := rpzTriggerQname
+		if objType == rpzAIpAddressObjType {
+			trigger = rpzTriggerIpAddress
+		}
+		if err := d.Set("trigger", trigger); err != nil {
+			return err
+		}
+		ipv4Addr, _ := rec["ipv4addr"].(string)
+		return d.Set("ipv4addr", ipv4Addr)
+	},
+}
+
+func resourceRpzRuleA() *schema.Resource {
+	return resourceRpzRuleRecord(rpzRuleTypeA)
+}
@@ -0,0 +1,67 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+const (
+	rpzAaaaObjType          = "record:rpz:aaaa"
+	rpzAaaaIpAddressObjType = "record:rpz:aaaa:ipaddress"
+)
+
+var rpzRuleTypeAAAA = &rpzRuleType{
+	title:        "RPZ substitute AAAA-record rule",
+	returnFields: []string{"ipv6addr"},
+	fields: map[string]*schema.Schema{
+		"trigger": rpzRuleTriggerSchema(rpzTriggerQname, rpzTriggerIpAddress),
+		"ipv6addr": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPv6Address,
+			Description:  "The IPv6 address to substitute the response with.",
+		},
+	},
+	objects: map[string]func() ibclient.IBObject{
+		rpzAaaaObjType:          func() ibclient.IBObject { return &ibclient.RecordRpzAaaa{} },
+		rpzAaaaIpAddressObjType: func() ibclient.IBObject { return &ibclient.RecordRpzAaaaIpaddress{} },
+	},
+	objectType: func(d *schema.ResourceData) string {
+		if d.Get("trigger").(string) == rpzTriggerIpAddress {
+			return rpzAaaaIpAddressObjType
+		}
+		return rpzAaaaObjType
+	},
+	form: func(d *schema.ResourceData, objType string, base *rpzRuleBase) (ibclient.IBObject, error) {
+		ipv6Addr := utils.StringPtr(d.Get("ipv6addr").(string))
+		if objType == rpzAaaaIpAddressObjType {
+			return &ibclient.RecordRpzAaaaIpaddress{
+				Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+				Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+				Ipv6Addr: ipv6Addr,
+			}, nil
+		}
+		return &ibclient.RecordRpzAaaa{
+			Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+			Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+			Ipv6Addr: ipv6Addr,
+		}, nil
+	},
+	set: func(d *schema.ResourceData, objType string, rec map[string]interface{}) error {
+		trigger := rpzTriggerQname
+		if objType == rpzAaaaIpAddressObjType {
+			trigger = rpzTriggerIpAddress
+		}
+		if err := d.Set("trigger", trigger); err != nil {
+			return err
+		}
+		ipv6Addr, _ := rec["ipv6addr"].(string)
+		return d.Set("ipv6addr", ipv6Addr)
+	},
+}
+
+func resourceRpzRuleAAAA() *schema.Resource {
+	return resourceRpzRuleRecord(rpzRuleTypeAAAA)
+}
@@ -0,0 +1,174 @@
+package infoblox
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+const (
+	rpzCnameObjType                  = "record:rpz:cname"
+	rpzCnameIpAddressObjType         = "record:rpz:cname:ipaddress"
+	rpzCnameIpAddressDnObjType       = "record:rpz:cname:ipaddressdn"
+	rpzCnameClientIpAddressObjType   = "record:rpz:cname:clientipaddress"
+	rpzCnameClientIpAddressDnObjType = "record:rpz:cname:clientipaddressdn"
+)
+
+// rpzRuleTypeCname describes the rules with passthru, block and
+// substitute (domain name) actions, which are all represented
+// by the 'record:rpz:cname*' family of WAPI objects.
+var rpzRuleTypeCname = &rpzRuleType{
+	title:        "RPZ rule",
+	returnFields: []string{"canonical"},
+	fields: map[string]*schema.Schema{
+		"trigger": rpzRuleTriggerSchema(rpzTriggerQname, rpzTriggerIpAddress, rpzTriggerClientIpAddress),
+		"action": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				rpzActionPassthru, rpzActionBlockNxdomain, rpzActionBlockNodata, rpzActionSubstitute}, false),
+			Description: "The action of the rule: PASSTHRU, BLOCK_NXDOMAIN, BLOCK_NODATA or SUBSTITUTE.",
+		},
+		"substitute_name": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "",
+			DiffSuppressFunc: suppressDnsNameDiff,
+			Description:      "The domain name to redirect to; required if 'action' is SUBSTITUTE.",
+		},
+	},
+	objects: map[string]func() ibclient.IBObject{
+		rpzCnameObjType:                  func() ibclient.IBObject { return &ibclient.RecordRpzCname{} },
+		rpzCnameIpAddressObjType:         func() ibclient.IBObject { return &ibclient.RecordRpzCnameIpaddress{} },
+		rpzCnameIpAddressDnObjType:       func() ibclient.IBObject { return &ibclient.RecordRpzCnameIpaddressdn{} },
+		rpzCnameClientIpAddressObjType:   func() ibclient.IBObject { return &ibclient.RecordRpzCnameClientipaddress{} },
+		rpzCnameClientIpAddressDnObjType: func() ibclient.IBObject { return &ibclient.RecordRpzCnameClientipaddressdn{} },
+	},
+	objectType: func(d *schema.ResourceData) string {
+		substitute := d.Get("action").(string) == rpzActionSubstitute
+		switch d.Get("trigger").(string) {
+		case rpzTriggerIpAddress:
+			if substitute {
+				return rpzCnameIpAddressDnObjType
+			}
+			return rpzCnameIpAddressObjType
+		case rpzTriggerClientIpAddress:
+			if substitute {
+				return rpzCnameClientIpAddressDnObjType
+			}
+			return rpzCnameClientIpAddressObjType
+		default:
+			return rpzCnameObjType
+		}
+	},
+	form: func(d *schema.ResourceData, objType string, base *rpzRuleBase) (ibclient.IBObject, error) {
+		canonical, err := rpzCanonicalFromAction(d)
+		if err != nil {
+			return nil, err
+		}
+
+		switch objType {
+		case rpzCnameIpAddressObjType:
+			return &ibclient.RecordRpzCnameIpaddress{
+				Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+				Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+				Canonical: &canonical,
+			}, nil
+		case rpzCnameIpAddressDnObjType:
+			return &ibclient.RecordRpzCnameIpaddressdn{
+				Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+				Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+				Canonical: &canonical,
+			}, nil
+		case rpzCnameClientIpAddressObjType:
+			return &ibclient.RecordRpzCnameClientipaddress{
+				Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+				Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+				Canonical: &canonical,
+			}, nil
+		case rpzCnameClientIpAddressDnObjType:
+			return &ibclient.RecordRpzCnameClientipaddressdn{
+				Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+				Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+				Canonical: &canonical,
+			}, nil
+		default:
+			return &ibclient.RecordRpzCname{
+				Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+				Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+				Canonical: &canonical,
+			}, nil
+		}
+	},
+	set: func(d *schema.ResourceData, objType string, rec map[string]interface{}) error {
+		canonical, _ := rec["canonical"].(string)
+
+		trigger := rpzTriggerQname
+		switch objType {
+		case rpzCnameIpAddressObjType, rpzCnameIpAddressDnObjType:
+			trigger = rpzTriggerIpAddress
+		case rpzCnameClientIpAddressObjType, rpzCnameClientIpAddressDnObjType:
+			trigger = rpzTriggerClientIpAddress
+		}
+		if err := d.Set("trigger", trigger); err != nil {
+			return err
+		}
+
+		action, substituteName := rpzActionFromCanonical(canonical, objType, d.Get("name").(string))
+		if err := d.Set("action", action); err != nil {
+			return err
+		}
+		return d.Set("substitute_name", substituteName)
+	},
+}
+
+// rpzCanonicalFromAction encodes the rule's action
+// into the value of the 'canonical' field of the WAPI object.
+func rpzCanonicalFromAction(d *schema.ResourceData) (string, error) {
+	action := d.Get("action").(string)
+	substituteName := dnsNameForWapi(d.Get("substitute_name").(string))
+	if action == rpzActionSubstitute && substituteName == "" {
+		return "", fmt.Errorf("'substitute_name' must be set when 'action' is %s", rpzActionSubstitute)
+	}
+	if action != rpzActionSubstitute && substituteName != "" {
+		return "", fmt.Errorf("'substitute_name' may be set only when 'action' is %s", rpzActionSubstitute)
+	}
+
+	switch action {
+	case rpzActionBlockNxdomain:
+		return rpzCanonicalNxdomain, nil
+	case rpzActionBlockNodata:
+		return rpzCanonicalNodata, nil
+	case rpzActionPassthru:
+		// A passthru domain name rule points to itself.
+		if d.Get("trigger").(string) == rpzTriggerQname {
+			return d.Get("name").(string), nil
+		}
+		return rpzCanonicalPassthru, nil
+	default:
+		return substituteName, nil
+	}
+}
+
+// rpzActionFromCanonical is the reverse of rpzCanonicalFromAction,
+// 'name' is the rule's name relative to the response policy zone.
+func rpzActionFromCanonical(canonical string, objType string, name string) (string, string) {
+	switch {
+	case objType == rpzCnameIpAddressDnObjType || objType == rpzCnameClientIpAddressDnObjType:
+		return rpzActionSubstitute, canonical
+	case canonical == rpzCanonicalNxdomain:
+		return rpzActionBlockNxdomain, ""
+	case canonical == rpzCanonicalNodata:
+		return rpzActionBlockNodata, ""
+	case canonical == rpzCanonicalPassthru || (objType == rpzCnameObjType && canonical == name):
+		return rpzActionPassthru, ""
+	default:
+		return rpzActionSubstitute, canonical
+	}
+}
+
+func resourceRpzRule() *schema.Resource {
+	return resourceRpzRuleRecord(rpzRuleTypeCname)
+}
@@ -0,0 +1,111 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+const rpzNaptrObjType = "record:rpz:naptr"
+
+var rpzRuleTypeNAPTR = &rpzRuleType{
+	title:        "RPZ substitute NAPTR-record rule",
+	returnFields: []string{"order", "preference", "flags", "services", "regexp", "replacement"},
+	fields: map[string]*schema.Schema{
+		"order": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+			Description:  "The order in which the NAPTR-records must be processed, 0 to 65535 (inclusive).",
+		},
+		"preference": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+			Description:  "The order in which NAPTR-records with equal 'order' values should be processed.",
+		},
+		"flags": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The flags which control the interpretation of the fields of the NAPTR-record.",
+		},
+		"services": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The services and protocols available at the domain name after the rewrite.",
+		},
+		"regexp": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The regular expression-based rewrite rule.",
+		},
+		"replacement": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "The replacement domain name, '.' if the regular expression is used instead.",
+			DiffSuppressFunc: suppressDnsNameDiff,
+		},
+	},
+	objects: map[string]func() ibclient.IBObject{
+		rpzNaptrObjType: func() ibclient.IBObject { return &ibclient.RecordRpzNaptr{} },
+	},
+	objectType: func(d *schema.ResourceData) string {
+		return rpzNaptrObjType
+	},
+	form: func(d *schema.ResourceData, objType string, base *rpzRuleBase) (ibclient.IBObject, error) {
+		// '.' is the root name, which means no replacement.
+		replacement := d.Get("replacement").(string)
+		if replacement != "." {
+			replacement = dnsNameForWapi(replacement)
+		}
+		return &ibclient.RecordRpzNaptr{
+			Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+			Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+			Order:       utils.Uint32Ptr(uint32(d.Get("order").(int))),
+			Preference:  utils.Uint32Ptr(uint32(d.Get("preference").(int))),
+			Flags:       utils.StringPtr(d.Get("flags").(string)),
+			Services:    utils.StringPtr(d.Get("services").(string)),
+			Regexp:      utils.StringPtr(d.Get("regexp").(string)),
+			Replacement: utils.StringPtr(replacement),
+		}, nil
+	},
+	set: func(d *schema.ResourceData, objType string, rec map[string]interface{}) error {
+		var obj ibclient.RecordRpzNaptr
+		if err := convertWapiObject(rec, &obj); err != nil {
+			return err
+		}
+		for field, val := range map[string]*uint32{
+			"order":      obj.Order,
+			"preference": obj.Preference,
+		} {
+			if val != nil {
+				if err := d.Set(field, int(*val)); err != nil {
+					return err
+				}
+			}
+		}
+		for field, val := range map[string]*string{
+			"flags":       obj.Flags,
+			"services":    obj.Services,
+			"regexp":      obj.Regexp,
+			"replacement": obj.Replacement,
+		} {
+			str := ""
+			if val != nil {
+				str = *val
+			}
+			if err := d.Set(field, str); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+func resourceRpzRuleNAPTR() *schema.Resource {
+	return resourceRpzRuleRecord(rpzRuleTypeNAPTR)
+}
@@ -0,0 +1,43 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+const rpzPtrObjType = "record:rpz:ptr"
+
+var rpzRuleTypePTR = &rpzRuleType{
+	title:        "RPZ substitute PTR-record rule",
+	returnFields: []string{"ptrdname"},
+	fields: map[string]*schema.Schema{
+		"ptrdname": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "The domain name the PTR-record points to.",
+			DiffSuppressFunc: suppressDnsNameDiff,
+		},
+	},
+	objects: map[string]func() ibclient.IBObject{
+		rpzPtrObjType: func() ibclient.IBObject { return &ibclient.RecordRpzPtr{} },
+	},
+	objectType: func(d *schema.ResourceData) string {
+		return rpzPtrObjType
+	},
+	form: func(d *schema.ResourceData, objType string, base *rpzRuleBase) (ibclient.IBObject, error) {
+		return &ibclient.RecordRpzPtr{
+			Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+			Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+			PtrdName: utils.StringPtr(dnsNameForWapi(d.Get("ptrdname").(string))),
+		}, nil
+	},
+	set: func(d *schema.ResourceData, objType string, rec map[string]interface{}) error {
+		ptrdName, _ := rec["ptrdname"].(string)
+		return d.Set("ptrdname", ptrdName)
+	},
+}
+
+func resourceRpzRulePTR() *schema.Resource {
+	return resourceRpzRuleRecord(rpzRuleTypePTR)
+}
@@ -0,0 +1,82 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+const rpzSrvObjType = "record:rpz:srv"
+
+var rpzRuleTypeSRV = &rpzRuleType{
+	title:        "RPZ substitute SRV-record rule",
+	returnFields: []string{"priority", "weight", "port", "target"},
+	fields: map[string]*schema.Schema{
+		"priority": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+			Description:  "Configures the priority (0..65535) for this SRV-record.",
+		},
+		"weight": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+			Description:  "Configures weight of the SRV-record, valid values are 0..65535.",
+		},
+		"port": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+			Description:  "Configures port number (0..65535) for this SRV-record.",
+		},
+		"target": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "Provides service for domain name in the SRV-record.",
+			DiffSuppressFunc: suppressDnsNameDiff,
+		},
+	},
+	objects: map[string]func() ibclient.IBObject{
+		rpzSrvObjType: func() ibclient.IBObject { return &ibclient.RecordRpzSrv{} },
+	},
+	objectType: func(d *schema.ResourceData) string {
+		return rpzSrvObjType
+	},
+	form: func(d *schema.ResourceData, objType string, base *rpzRuleBase) (ibclient.IBObject, error) {
+		return &ibclient.RecordRpzSrv{
+			Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+			Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+			Priority: utils.Uint32Ptr(uint32(d.Get("priority").(int))),
+			Weight:   utils.Uint32Ptr(uint32(d.Get("weight").(int))),
+			Port:     utils.Uint32Ptr(uint32(d.Get("port").(int))),
+			Target:   utils.StringPtr(dnsNameForWapi(d.Get("target").(string))),
+		}, nil
+	},
+	set: func(d *schema.ResourceData, objType string, rec map[string]interface{}) error {
+		var obj ibclient.RecordRpzSrv
+		if err := convertWapiObject(rec, &obj); err != nil {
+			return err
+		}
+		for field, val := range map[string]*uint32{
+			"priority": obj.Priority,
+			"weight":   obj.Weight,
+			"port":     obj.Port,
+		} {
+			if val != nil {
+				if err := d.Set(field, int(*val)); err != nil {
+					return err
+				}
+			}
+		}
+		if obj.Target != nil {
+			return d.Set("target", *obj.Target)
+		}
+		return nil
+	},
+}
+
+func resourceRpzRuleSRV() *schema.Resource {
+	return resourceRpzRuleRecord(rpzRuleTypeSRV)
+}
@@ -0,0 +1,145 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckRpzRulesDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		var t *rpzRuleType
+		switch rs.Type {
+		case "infoblox_rpz_rule":
+			t = rpzRuleTypeCname
+		case "infoblox_rpz_rule_a":
+			t = rpzRuleTypeA
+		case "infoblox_rpz_rule_txt":
+			t = rpzRuleTypeTXT
+		default:
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		for objType := range t.objects {
+			var rec map[string]interface{}
+			err := connector.GetObject(t.newEmptyObject(objType), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &rec)
+			if err == nil && rec != nil {
+				return fmt.Errorf("object '%s' still exists", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// testAccRpzCnameRuleCanonical checks the way the rule's action is encoded on NIOS side.
+func testAccRpzCnameRuleCanonical(resPath string, expectedName string, expectedCanonical string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.Attributes["internal_id"] == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		var rec ibclient.RecordRpzCname
+		err := connector.GetObject(
+			rpzRuleTypeCname.newEmptyObject(rpzCnameObjType), res.Primary.Attributes["ref"],
+			ibclient.NewQueryParams(false, nil), &rec)
+		if err != nil {
+			return err
+		}
+
+		if *rec.Name != expectedName {
+			return fmt.Errorf("'name' does not match: got '%s', expected '%s'", *rec.Name, expectedName)
+		}
+		if *rec.Canonical != expectedCanonical {
+			return fmt.Errorf("'canonical' does not match: got '%s', expected '%s'", *rec.Canonical, expectedCanonical)
+		}
+		return nil
+	}
+}
+
+func TestAccResourceRpzRules(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRpzRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_rp" "rpz" {
+						fqdn = "rpz-rules.test.com"
+					}
+					resource "infoblox_rpz_rule" "block" {
+						name = "malware.example.com"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						action = "BLOCK_NXDOMAIN"
+					}
+					resource "infoblox_rpz_rule" "nodata" {
+						name = "tracker.example.com"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						action = "BLOCK_NODATA"
+					}
+					resource "infoblox_rpz_rule" "passthru" {
+						name = "good.example.com"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						action = "PASSTHRU"
+					}
+					resource "infoblox_rpz_rule_a" "sinkhole" {
+						name = "phishing.example.com"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						ipv4addr = "10.0.0.53"
+					}
+					resource "infoblox_rpz_rule_txt" "notice" {
+						name = "phishing.example.com"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						text = "blocked by policy"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRpzCnameRuleCanonical("infoblox_rpz_rule.block", "malware.example.com.rpz-rules.test.com", ""),
+					testAccRpzCnameRuleCanonical("infoblox_rpz_rule.nodata", "tracker.example.com.rpz-rules.test.com", "*"),
+					testAccRpzCnameRuleCanonical("infoblox_rpz_rule.passthru", "good.example.com.rpz-rules.test.com", "good.example.com"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.block", "action", "BLOCK_NXDOMAIN"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.nodata", "action", "BLOCK_NODATA"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.passthru", "action", "PASSTHRU"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule_a.sinkhole", "name", "phishing.example.com"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule_a.sinkhole", "trigger", "QNAME"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule_a.sinkhole", "ipv4addr", "10.0.0.53"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule_txt.notice", "text", "blocked by policy"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_rp" "rpz" {
+						fqdn = "rpz-rules.test.com"
+					}
+					resource "infoblox_rpz_rule" "block" {
+						name = "malware.example.com"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						action = "SUBSTITUTE"
+						substitute_name = "walled-garden.test.com"
+						comment = "redirected to the walled garden"
+					}
+					resource "infoblox_rpz_rule" "nodata" {
+						name = "tracker.example.com"
+						rp_zone = infoblox_zone_rp.rpz.fqdn
+						action = "BLOCK_NXDOMAIN"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccRpzCnameRuleCanonical("infoblox_rpz_rule.block", "malware.example.com.rpz-rules.test.com", "walled-garden.test.com"),
+					testAccRpzCnameRuleCanonical("infoblox_rpz_rule.nodata", "tracker.example.com.rpz-rules.test.com", ""),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.block", "action", "SUBSTITUTE"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.block", "substitute_name", "walled-garden.test.com"),
+					resource.TestCheckResourceAttr("infoblox_rpz_rule.block", "comment", "redirected to the walled garden"),
+				),
+			},
+		},
+	})
+}
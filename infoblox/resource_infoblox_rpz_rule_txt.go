@@ -0,0 +1,42 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+const rpzTxtObjType = "record:rpz:txt"
+
+var rpzRuleTypeTXT = &rpzRuleType{
+	title:        "RPZ substitute TXT-record rule",
+	returnFields: []string{"text"},
+	fields: map[string]*schema.Schema{
+		"text": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Data to be associated with the TXT-record.",
+		},
+	},
+	objects: map[string]func() ibclient.IBObject{
+		rpzTxtObjType: func() ibclient.IBObject { return &ibclient.RecordRpzTxt{} },
+	},
+	objectType: func(d *schema.ResourceData) string {
+		return rpzTxtObjType
+	},
+	form: func(d *schema.ResourceData, objType string, base *rpzRuleBase) (ibclient.IBObject, error) {
+		return &ibclient.RecordRpzTxt{
+			Name: base.Name, RpZone: base.RpZone, View: base.View, Comment: base.Comment,
+			Disable: base.Disable, Ttl: base.Ttl, UseTtl: base.UseTtl, Ea: base.Ea,
+			Text: utils.StringPtr(d.Get("text").(string)),
+		}, nil
+	},
+	set: func(d *schema.ResourceData, objType string, rec map[string]interface{}) error {
+		text, _ := rec["text"].(string)
+		return d.Set("text", text)
+	},
+}
+
+func resourceRpzRuleTXT() *schema.Resource {
+	return resourceRpzRuleRecord(rpzRuleTypeTXT)
+}
@@ -0,0 +1,452 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+// sharedRecordBase holds the fields which all 'sharedrecord:*' WAPI objects have in common.
+type sharedRecordBase struct {
+	Ref               string      `json:"_ref,omitempty"`
+	Name              *string     `json:"name,omitempty"`
+	SharedRecordGroup *string     `json:"shared_record_group,omitempty"`
+	Comment           *string     `json:"comment,omitempty"`
+	Disable           *bool       `json:"disable,omitempty"`
+	Ttl               *uint32     `json:"ttl,omitempty"`
+	UseTtl            *bool       `json:"use_ttl,omitempty"`
+	Ea                ibclient.EA `json:"extattrs"`
+}
+
+var sharedRecordCommonReturnFields = []string{
+	"name",
+	"shared_record_group",
+	"comment",
+	"disable",
+	"ttl",
+	"use_ttl",
+	"extattrs",
+}
+
+// sharedRecordType describes the specifics of a particular kind of shared records,
+// the rest of the resource's logic is common for all of them.
+type sharedRecordType struct {
+	// Human-readable name of the record type, to be used in messages.
+	title string
+
+	// Type-specific return fields, in addition to sharedRecordCommonReturnFields.
+	returnFields []string
+
+	// Type-specific fields of the resource's schema.
+	fields map[string]*schema.Schema
+
+	// Returns an empty WAPI object of the appropriate type.
+	newObject func() ibclient.IBObject
+
+	// Returns a WAPI object with the common fields taken from 'base'
+	// and type-specific fields taken from the resource's data.
+	form func(d *schema.ResourceData, base *sharedRecordBase) (ibclient.IBObject, error)
+
+	// Sets type-specific fields of the resource from the WAPI object.
+	set func(d *schema.ResourceData, rec map[string]interface{}) error
+}
+
+func (t *sharedRecordType) newEmptyObject() ibclient.IBObject {
+	obj := t.newObject()
+	obj.SetReturnFields(append(append([]string{}, sharedRecordCommonReturnFields...), t.returnFields...))
+
+	return obj
+}
+
+func resourceSharedRecord(t *sharedRecordType) *schema.Resource {
+	recSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "",
+			Description: "The name of the record, relative to the zones the shared record group is associated with." +
+				" An empty value means the zone's apex.",
+			DiffSuppressFunc: suppressDnsNameDiff,
+		},
+		"shared_record_group": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the shared record group the record belongs to.",
+		},
+		"ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     ttlUndef,
+			Description: "TTL value of the record.",
+		},
+		"disable": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Determines if the record is disabled or not.",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Description of the record.",
+		},
+		"ext_attrs": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Extensible attributes of the record to be added/updated, as a map in JSON format",
+		},
+		"internal_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "Internal ID of an object at NIOS side," +
+				" used by Infoblox Terraform plugin to search for a NIOS's object" +
+				" which corresponds to the Terraform resource.",
+		},
+		"ref": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "NIOS object's reference, not to be set by a user.",
+		},
+	}
+	for k, v := range t.fields {
+		recSchema[k] = v
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return resourceSharedRecordCreate(t, d, m)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return resourceSharedRecordGet(t, d, m)
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			return resourceSharedRecordUpdate(t, d, m)
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return resourceSharedRecordDelete(t, d, m)
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				return resourceSharedRecordImport(t, d, m)
+			},
+		},
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Schema: recSchema,
+	}
+}
+
+// formSharedRecordBase gets the fields, common for all shared record types,
+// from the resource's data.
+func formSharedRecordBase(d *schema.ResourceData) (*sharedRecordBase, error) {
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return nil, fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	return &sharedRecordBase{
+		Name:    utils.StringPtr(dnsNameForWapi(d.Get("name").(string))),
+		Comment: utils.StringPtr(d.Get("comment").(string)),
+		Disable: utils.BoolPtr(d.Get("disable").(bool)),
+		Ttl:     utils.Uint32Ptr(ttl),
+		UseTtl:  utils.BoolPtr(useTtl),
+	}, nil
+}
+
+func setSharedRecordFields(t *sharedRecordType, d *schema.ResourceData, rec map[string]interface{}) (*sharedRecordBase, error) {
+	var base sharedRecordBase
+	if err := convertWapiObject(rec, &base); err != nil {
+		return nil, err
+	}
+
+	ttl := ttlUndef
+	if base.UseTtl != nil && *base.UseTtl && base.Ttl != nil {
+		ttl = int(*base.Ttl)
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return nil, err
+	}
+
+	name := ""
+	if base.Name != nil {
+		name = *base.Name
+	}
+	if err := d.Set("name", name); err != nil {
+		return nil, err
+	}
+
+	if base.SharedRecordGroup != nil {
+		if err := d.Set("shared_record_group", *base.SharedRecordGroup); err != nil {
+			return nil, err
+		}
+	}
+
+	comment := ""
+	if base.Comment != nil {
+		comment = *base.Comment
+	}
+	if err := d.Set("comment", comment); err != nil {
+		return nil, err
+	}
+
+	disable := false
+	if base.Disable != nil {
+		disable = *base.Disable
+	}
+	if err := d.Set("disable", disable); err != nil {
+		return nil, err
+	}
+
+	if err := t.set(d, rec); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("ref", base.Ref); err != nil {
+		return nil, err
+	}
+
+	return &base, nil
+}
+
+func resourceSharedRecordCreate(t *sharedRecordType, d *schema.ResourceData, m interface{}) error {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+	}
+
+	base, err := formSharedRecordBase(d)
+	if err != nil {
+		return err
+	}
+	base.SharedRecordGroup = utils.StringPtr(d.Get("shared_record_group").(string))
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	base.Ea = extAttrs
+
+	rec, err := t.form(d, base)
+	if err != nil {
+		return err
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(rec)
+	if err != nil {
+		return fmt.Errorf("error creating %s: %s", t.title, err)
+	}
+
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return err
+	}
+
+	return resourceSharedRecordGet(t, d, m)
+}
+
+func resourceSharedRecordGet(t *sharedRecordType, d *schema.ResourceData, m interface{}) error {
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	var rec map[string]interface{}
+	err = searchGenericObjectByRefOrInternalId(t.newEmptyObject(), d, m, &rec)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	base, err := setSharedRecordFields(t, d, rec)
+	if err != nil {
+		return err
+	}
+
+	delete(base.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(base.Ea, extAttrs)
+
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(base.Ref)
+
+	return nil
+}
+
+func resourceSharedRecordUpdate(t *sharedRecordType, d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			for _, field := range []string{"name", "ttl", "disable", "comment", "ext_attrs"} {
+				prevVal, _ := d.GetChange(field)
+				_ = d.Set(field, prevVal)
+			}
+			for field := range t.fields {
+				prevVal, _ := d.GetChange(field)
+				_ = d.Set(field, prevVal)
+			}
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+	}
+	if d.HasChange("shared_record_group") {
+		return fmt.Errorf("changing the value of 'shared_record_group' field is not allowed")
+	}
+
+	base, err := formSharedRecordBase(d)
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
+
+	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	var currentRec sharedRecordBase
+	err = searchGenericObjectByRefOrInternalId(t.newEmptyObject(), d, m, &currentRec)
+	if err != nil {
+		return fmt.Errorf("failed to read %s for update operation: %w", t.title, err)
+	}
+
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	base.Ea, err = mergeEAs(currentRec.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return err
+	}
+
+	rec, err := t.form(d, base)
+	if err != nil {
+		return err
+	}
+
+	ref, err := connector.UpdateObject(rec, currentRec.Ref)
+	if err != nil {
+		return fmt.Errorf("error updating %s: %s", t.title, err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return err
+	}
+
+	return resourceSharedRecordGet(t, d, m)
+}
+
+func resourceSharedRecordDelete(t *sharedRecordType, d *schema.ResourceData, m interface{}) error {
+	var rec sharedRecordBase
+	err := searchGenericObjectByRefOrInternalId(t.newEmptyObject(), d, m, &rec)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	connector := m.(ibclient.IBConnector)
+	if _, err = connector.DeleteObject(rec.Ref); err != nil {
+		return fmt.Errorf("deletion of %s failed: %s", t.title, err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSharedRecordImport(t *sharedRecordType, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(ibclient.IBConnector)
+
+	var rec map[string]interface{}
+	if err := connector.GetObject(t.newEmptyObject(), d.Id(), ibclient.NewQueryParams(false, nil), &rec); err != nil {
+		return nil, fmt.Errorf("failed getting %s: %s", t.title, err)
+	}
+
+	base, err := setSharedRecordFields(t, d, rec)
+	if err != nil {
+		return nil, err
+	}
+
+	if base.Ea != nil && len(base.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(base.Ea)
+		if err != nil {
+			return nil, err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(base.Ref)
+
+	if err = resourceSharedRecordUpdate(t, d, m); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
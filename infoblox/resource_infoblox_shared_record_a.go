@@ -0,0 +1,50 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+var sharedRecordTypeA = &sharedRecordType{
+	title:        "shared A-record",
+	returnFields: []string{"ipv4addr"},
+	fields: map[string]*schema.Schema{
+		"ip_addr": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPv4Address,
+			Description:  "IPv4 address the record points to.",
+		},
+	},
+	newObject: func() ibclient.IBObject {
+		return &ibclient.SharedRecordA{}
+	},
+	form: func(d *schema.ResourceData, base *sharedRecordBase) (ibclient.IBObject, error) {
+		return &ibclient.SharedRecordA{
+			Name:              base.Name,
+			SharedRecordGroup: base.SharedRecordGroup,
+			Comment:           base.Comment,
+			Disable:           base.Disable,
+			Ttl:               base.Ttl,
+			UseTtl:            base.UseTtl,
+			Ea:                base.Ea,
+			Ipv4Addr:          utils.StringPtr(d.Get("ip_addr").(string)),
+		}, nil
+	},
+	set: func(d *schema.ResourceData, rec map[string]interface{}) error {
+		var obj ibclient.SharedRecordA
+		if err := convertWapiObject(rec, &obj); err != nil {
+			return err
+		}
+		if obj.Ipv4Addr != nil {
+			return d.Set("ip_addr", *obj.Ipv4Addr)
+		}
+		return nil
+	},
+}
+
+func resourceSharedRecordA() *schema.Resource {
+	return resourceSharedRecord(sharedRecordTypeA)
+}
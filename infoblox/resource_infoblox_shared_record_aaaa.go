@@ -0,0 +1,50 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+var sharedRecordTypeAAAA = &sharedRecordType{
+	title:        "shared AAAA-record",
+	returnFields: []string{"ipv6addr"},
+	fields: map[string]*schema.Schema{
+		"ipv6_addr": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPv6Address,
+			Description:  "IPv6 address the record points to.",
+		},
+	},
+	newObject: func() ibclient.IBObject {
+		return &ibclient.SharedRecordAAAA{}
+	},
+	form: func(d *schema.ResourceData, base *sharedRecordBase) (ibclient.IBObject, error) {
+		return &ibclient.SharedRecordAAAA{
+			Name:              base.Name,
+			SharedRecordGroup: base.SharedRecordGroup,
+			Comment:           base.Comment,
+			Disable:           base.Disable,
+			Ttl:               base.Ttl,
+			UseTtl:            base.UseTtl,
+			Ea:                base.Ea,
+			Ipv6Addr:          utils.StringPtr(d.Get("ipv6_addr").(string)),
+		}, nil
+	},
+	set: func(d *schema.ResourceData, rec map[string]interface{}) error {
+		var obj ibclient.SharedRecordAAAA
+		if err := convertWapiObject(rec, &obj); err != nil {
+			return err
+		}
+		if obj.Ipv6Addr != nil {
+			return d.Set("ipv6_addr", *obj.Ipv6Addr)
+		}
+		return nil
+	},
+}
+
+func resourceSharedRecordAAAA() *schema.Resource {
+	return resourceSharedRecord(sharedRecordTypeAAAA)
+}
@@ -0,0 +1,49 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+var sharedRecordTypeCNAME = &sharedRecordType{
+	title:        "shared CNAME-record",
+	returnFields: []string{"canonical"},
+	fields: map[string]*schema.Schema{
+		"canonical": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "Canonical name of the record, in FQDN format.",
+			DiffSuppressFunc: suppressDnsNameDiff,
+		},
+	},
+	newObject: func() ibclient.IBObject {
+		return &ibclient.SharedrecordCname{}
+	},
+	form: func(d *schema.ResourceData, base *sharedRecordBase) (ibclient.IBObject, error) {
+		return &ibclient.SharedrecordCname{
+			Name:              base.Name,
+			SharedRecordGroup: base.SharedRecordGroup,
+			Comment:           base.Comment,
+			Disable:           base.Disable,
+			Ttl:               base.Ttl,
+			UseTtl:            base.UseTtl,
+			Ea:                base.Ea,
+			Canonical:         utils.StringPtr(dnsNameForWapi(d.Get("canonical").(string))),
+		}, nil
+	},
+	set: func(d *schema.ResourceData, rec map[string]interface{}) error {
+		var obj ibclient.SharedrecordCname
+		if err := convertWapiObject(rec, &obj); err != nil {
+			return err
+		}
+		if obj.Canonical != nil {
+			return d.Set("canonical", *obj.Canonical)
+		}
+		return nil
+	},
+}
+
+func resourceSharedRecordCNAME() *schema.Resource {
+	return resourceSharedRecord(sharedRecordTypeCNAME)
+}
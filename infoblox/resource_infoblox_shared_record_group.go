@@ -0,0 +1,368 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+// sharedRecordGroup represents the 'sharedrecordgroup' WAPI object.
+// ibclient.Sharedrecordgroup cannot be used as is, because it declares
+// zone associations as a list of strings, while WAPI expects a list of structures.
+type sharedRecordGroup struct {
+	ibclient.IBBase  `json:"-"`
+	Ref              string                     `json:"_ref,omitempty"`
+	Name             *string                    `json:"name,omitempty"`
+	Comment          *string                    `json:"comment,omitempty"`
+	ZoneAssociations []ibclient.Zoneassociation `json:"zone_associations"`
+	Ea               ibclient.EA                `json:"extattrs"`
+}
+
+func (sharedRecordGroup) ObjectType() string {
+	return "sharedrecordgroup"
+}
+
+func newEmptySharedRecordGroup() *sharedRecordGroup {
+	grp := &sharedRecordGroup{}
+	grp.SetReturnFields([]string{"name", "comment", "zone_associations", "extattrs"})
+
+	return grp
+}
+
+func resourceSharedRecordGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSharedRecordGroupCreate,
+		Read:   resourceSharedRecordGroupGet,
+		Update: resourceSharedRecordGroupUpdate,
+		Delete: resourceSharedRecordGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSharedRecordGroupImport,
+		},
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the shared record group.",
+			},
+			"zone_associations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "The list of zones the shared record group is associated with." +
+					" Records of the group are served by all the associated zones.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fqdn": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressDnsNameDiff,
+							Description:      "The FQDN of the authoritative forward zone.",
+						},
+						"view": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     defaultDNSView,
+							Description: "The DNS view in which the zone resides.",
+						},
+					},
+				},
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the shared record group.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the shared record group to be added/updated, as a map in JSON format",
+			},
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func convertZoneAssociationsFromInterface(zaList []interface{}) []ibclient.Zoneassociation {
+	res := make([]ibclient.Zoneassociation, 0, len(zaList))
+	for _, za := range zaList {
+		zaMap := za.(map[string]interface{})
+		res = append(res, ibclient.Zoneassociation{
+			Fqdn: dnsNameForWapi(zaMap["fqdn"].(string)),
+			View: zaMap["view"].(string),
+		})
+	}
+
+	return res
+}
+
+func convertZoneAssociationsToInterface(zaList []ibclient.Zoneassociation) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(zaList))
+	for _, za := range zaList {
+		res = append(res, map[string]interface{}{
+			"fqdn": za.Fqdn,
+			"view": za.View,
+		})
+	}
+
+	return res
+}
+
+func setSharedRecordGroupFields(d *schema.ResourceData, grp *sharedRecordGroup) error {
+	if grp.Name != nil {
+		if err := d.Set("name", *grp.Name); err != nil {
+			return err
+		}
+	}
+
+	comment := ""
+	if grp.Comment != nil {
+		comment = *grp.Comment
+	}
+	if err := d.Set("comment", comment); err != nil {
+		return err
+	}
+
+	if err := d.Set("zone_associations", convertZoneAssociationsToInterface(grp.ZoneAssociations)); err != nil {
+		return err
+	}
+
+	return d.Set("ref", grp.Ref)
+}
+
+func resourceSharedRecordGroupCreate(d *schema.ResourceData, m interface{}) error {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+	}
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+
+	grp := &sharedRecordGroup{
+		Name:             utils.StringPtr(d.Get("name").(string)),
+		Comment:          utils.StringPtr(d.Get("comment").(string)),
+		ZoneAssociations: convertZoneAssociationsFromInterface(d.Get("zone_associations").([]interface{})),
+		Ea:               extAttrs,
+	}
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(grp)
+	if err != nil {
+		return fmt.Errorf("error creating shared record group: %s", err)
+	}
+
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return err
+	}
+
+	return resourceSharedRecordGroupGet(d, m)
+}
+
+func resourceSharedRecordGroupGet(d *schema.ResourceData, m interface{}) error {
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	var grp sharedRecordGroup
+	err = searchGenericObjectByRefOrInternalId(newEmptySharedRecordGroup(), d, m, &grp)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if err = setSharedRecordGroupFields(d, &grp); err != nil {
+		return err
+	}
+
+	delete(grp.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(grp.Ea, extAttrs)
+
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(grp.Ref)
+
+	return nil
+}
+
+func resourceSharedRecordGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevName, _ := d.GetChange("name")
+			prevZoneAssociations, _ := d.GetChange("zone_associations")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("name", prevName.(string))
+			_ = d.Set("zone_associations", prevZoneAssociations)
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+	}
+
+	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
+
+	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	var currentGrp sharedRecordGroup
+	err = searchGenericObjectByRefOrInternalId(newEmptySharedRecordGroup(), d, m, &currentGrp)
+	if err != nil {
+		return fmt.Errorf("failed to read shared record group for update operation: %w", err)
+	}
+
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	mergedEAs, err := mergeEAs(currentGrp.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return err
+	}
+
+	grp := &sharedRecordGroup{
+		Name:             utils.StringPtr(d.Get("name").(string)),
+		Comment:          utils.StringPtr(d.Get("comment").(string)),
+		ZoneAssociations: convertZoneAssociationsFromInterface(d.Get("zone_associations").([]interface{})),
+		Ea:               mergedEAs,
+	}
+
+	ref, err := connector.UpdateObject(grp, currentGrp.Ref)
+	if err != nil {
+		return fmt.Errorf("error updating shared record group: %s", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return err
+	}
+
+	return resourceSharedRecordGroupGet(d, m)
+}
+
+func resourceSharedRecordGroupDelete(d *schema.ResourceData, m interface{}) error {
+	var grp sharedRecordGroup
+	err := searchGenericObjectByRefOrInternalId(newEmptySharedRecordGroup(), d, m, &grp)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	connector := m.(ibclient.IBConnector)
+	if _, err = connector.DeleteObject(grp.Ref); err != nil {
+		return fmt.Errorf("deletion of shared record group failed: %s", err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSharedRecordGroupImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(ibclient.IBConnector)
+
+	var grp sharedRecordGroup
+	if err := connector.GetObject(newEmptySharedRecordGroup(), d.Id(), ibclient.NewQueryParams(false, nil), &grp); err != nil {
+		return nil, fmt.Errorf("failed getting shared record group: %s", err)
+	}
+
+	if err := setSharedRecordGroupFields(d, &grp); err != nil {
+		return nil, err
+	}
+
+	if grp.Ea != nil && len(grp.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(grp.Ea)
+		if err != nil {
+			return nil, err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(grp.Ref)
+
+	if err := resourceSharedRecordGroupUpdate(d, m); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
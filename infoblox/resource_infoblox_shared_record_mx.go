@@ -0,0 +1,62 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+var sharedRecordTypeMX = &sharedRecordType{
+	title:        "shared MX-record",
+	returnFields: []string{"mail_exchanger", "preference"},
+	fields: map[string]*schema.Schema{
+		"mail_exchanger": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "Mail exchanger name in FQDN format.",
+			DiffSuppressFunc: suppressDnsNameDiff,
+		},
+		"preference": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+			Description:  "Preference value, 0 to 65535 (inclusive).",
+		},
+	},
+	newObject: func() ibclient.IBObject {
+		return &ibclient.SharedRecordMX{}
+	},
+	form: func(d *schema.ResourceData, base *sharedRecordBase) (ibclient.IBObject, error) {
+		return &ibclient.SharedRecordMX{
+			Name:              base.Name,
+			SharedRecordGroup: base.SharedRecordGroup,
+			Comment:           base.Comment,
+			Disable:           base.Disable,
+			Ttl:               base.Ttl,
+			UseTtl:            base.UseTtl,
+			Ea:                base.Ea,
+			MailExchanger:     utils.StringPtr(dnsNameForWapi(d.Get("mail_exchanger").(string))),
+			Preference:        utils.Uint32Ptr(uint32(d.Get("preference").(int))),
+		}, nil
+	},
+	set: func(d *schema.ResourceData, rec map[string]interface{}) error {
+		var obj ibclient.SharedRecordMX
+		if err := convertWapiObject(rec, &obj); err != nil {
+			return err
+		}
+		if obj.MailExchanger != nil {
+			if err := d.Set("mail_exchanger", *obj.MailExchanger); err != nil {
+				return err
+			}
+		}
+		if obj.Preference != nil {
+			return d.Set("preference", int(*obj.Preference))
+		}
+		return nil
+	},
+}
+
+func resourceSharedRecordMX() *schema.Resource {
+	return resourceSharedRecord(sharedRecordTypeMX)
+}
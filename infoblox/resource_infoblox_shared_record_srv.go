@@ -0,0 +1,86 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+var sharedRecordTypeSRV = &sharedRecordType{
+	title:        "shared SRV-record",
+	returnFields: []string{"priority", "weight", "port", "target"},
+	fields: map[string]*schema.Schema{
+		"priority": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+			Description:  "Configures the priority (0..65535) for this SRV-record.",
+		},
+		"weight": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+			Description:  "Configures weight of the SRV-record, valid values are 0..65535.",
+		},
+		"port": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+			Description:  "Configures port number (0..65535) for this SRV-record.",
+		},
+		"target": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "Provides service for domain name in the SRV-record.",
+			DiffSuppressFunc: suppressDnsNameDiff,
+		},
+	},
+	newObject: func() ibclient.IBObject {
+		return &ibclient.SharedrecordSrv{}
+	},
+	form: func(d *schema.ResourceData, base *sharedRecordBase) (ibclient.IBObject, error) {
+		return &ibclient.SharedrecordSrv{
+			Name:              base.Name,
+			SharedRecordGroup: base.SharedRecordGroup,
+			Comment:           base.Comment,
+			Disable:           base.Disable,
+			Ttl:               base.Ttl,
+			UseTtl:            base.UseTtl,
+			Ea:                base.Ea,
+			Priority:          utils.Uint32Ptr(uint32(d.Get("priority").(int))),
+			Weight:            utils.Uint32Ptr(uint32(d.Get("weight").(int))),
+			Port:              utils.Uint32Ptr(uint32(d.Get("port").(int))),
+			Target:            utils.StringPtr(dnsNameForWapi(d.Get("target").(string))),
+		}, nil
+	},
+	set: func(d *schema.ResourceData, rec map[string]interface{}) error {
+		var obj ibclient.SharedrecordSrv
+		if err := convertWapiObject(rec, &obj); err != nil {
+			return err
+		}
+		if obj.Priority != nil {
+			if err := d.Set("priority", int(*obj.Priority)); err != nil {
+				return err
+			}
+		}
+		if obj.Weight != nil {
+			if err := d.Set("weight", int(*obj.Weight)); err != nil {
+				return err
+			}
+		}
+		if obj.Port != nil {
+			if err := d.Set("port", int(*obj.Port)); err != nil {
+				return err
+			}
+		}
+		if obj.Target != nil {
+			return d.Set("target", *obj.Target)
+		}
+		return nil
+	},
+}
+
+func resourceSharedRecordSRV() *schema.Resource {
+	return resourceSharedRecord(sharedRecordTypeSRV)
+}
@@ -0,0 +1,163 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+func testAccCheckSharedRecordsDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		var obj ibclient.IBObject
+		switch rs.Type {
+		case "infoblox_shared_record_group":
+			obj = newEmptySharedRecordGroup()
+		case "infoblox_shared_record_mx":
+			obj = sharedRecordTypeMX.newEmptyObject()
+		case "infoblox_shared_record_txt":
+			obj = sharedRecordTypeTXT.newEmptyObject()
+		default:
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var rec map[string]interface{}
+		err := connector.GetObject(obj, rs.Primary.ID, ibclient.NewQueryParams(false, nil), &rec)
+		if err == nil && rec != nil {
+			return fmt.Errorf("object '%s' still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccSharedRecordGroupCompare(t *testing.T, resPath string, expectedGrp *sharedRecordGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.Attributes["internal_id"] == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		var grp sharedRecordGroup
+		err := connector.GetObject(newEmptySharedRecordGroup(), res.Primary.Attributes["ref"], ibclient.NewQueryParams(false, nil), &grp)
+		if err != nil {
+			return err
+		}
+
+		if *grp.Name != *expectedGrp.Name {
+			return fmt.Errorf("'name' does not match: got '%s', expected '%s'", *grp.Name, *expectedGrp.Name)
+		}
+
+		if len(grp.ZoneAssociations) != len(expectedGrp.ZoneAssociations) {
+			return fmt.Errorf(
+				"the number of zone associations does not match: got '%d', expected '%d'",
+				len(grp.ZoneAssociations), len(expectedGrp.ZoneAssociations))
+		}
+		for i, za := range grp.ZoneAssociations {
+			if za.Fqdn != expectedGrp.ZoneAssociations[i].Fqdn || za.View != expectedGrp.ZoneAssociations[i].View {
+				return fmt.Errorf(
+					"zone association #%d does not match: got '%s/%s', expected '%s/%s'",
+					i, za.View, za.Fqdn, expectedGrp.ZoneAssociations[i].View, expectedGrp.ZoneAssociations[i].Fqdn)
+			}
+		}
+
+		return validateEAs(grp.Ea, expectedGrp.Ea)
+	}
+}
+
+func TestAccResourceSharedRecords(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedRecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "vanity1.test.com"
+					}
+					resource "infoblox_zone_auth" "zone2" {
+						fqdn = "vanity2.test.com"
+					}
+					resource "infoblox_shared_record_group" "grp" {
+						name = "vanity-mail"
+						comment = "mail settings for vanity zones"
+						zone_associations {
+							fqdn = infoblox_zone_auth.zone1.fqdn
+						}
+						zone_associations {
+							fqdn = infoblox_zone_auth.zone2.fqdn
+						}
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}
+					resource "infoblox_shared_record_mx" "mx" {
+						shared_record_group = infoblox_shared_record_group.grp.name
+						mail_exchanger = "mx1.mail.test.com"
+						preference = 10
+					}
+					resource "infoblox_shared_record_txt" "spf" {
+						shared_record_group = infoblox_shared_record_group.grp.name
+						text = "v=spf1 include:mail.test.com -all"
+						ttl = 3600
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccSharedRecordGroupCompare(t, "infoblox_shared_record_group.grp", &sharedRecordGroup{
+						Name: utils.StringPtr("vanity-mail"),
+						ZoneAssociations: []ibclient.Zoneassociation{
+							{Fqdn: "vanity1.test.com", View: "default"},
+							{Fqdn: "vanity2.test.com", View: "default"},
+						},
+						Ea: ibclient.EA{"Site": "HQ"},
+					}),
+					resource.TestCheckResourceAttr("infoblox_shared_record_mx.mx", "mail_exchanger", "mx1.mail.test.com"),
+					resource.TestCheckResourceAttr("infoblox_shared_record_mx.mx", "preference", "10"),
+					resource.TestCheckResourceAttr("infoblox_shared_record_mx.mx", "name", ""),
+					resource.TestCheckResourceAttr("infoblox_shared_record_txt.spf", "text", "v=spf1 include:mail.test.com -all"),
+					resource.TestCheckResourceAttr("infoblox_shared_record_txt.spf", "ttl", "3600"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone1" {
+						fqdn = "vanity1.test.com"
+					}
+					resource "infoblox_zone_auth" "zone2" {
+						fqdn = "vanity2.test.com"
+					}
+					resource "infoblox_shared_record_group" "grp" {
+						name = "vanity-mail"
+						zone_associations {
+							fqdn = infoblox_zone_auth.zone1.fqdn
+						}
+					}
+					resource "infoblox_shared_record_mx" "mx" {
+						shared_record_group = infoblox_shared_record_group.grp.name
+						mail_exchanger = "mx2.mail.test.com"
+						preference = 20
+						comment = "secondary MX"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccSharedRecordGroupCompare(t, "infoblox_shared_record_group.grp", &sharedRecordGroup{
+						Name: utils.StringPtr("vanity-mail"),
+						ZoneAssociations: []ibclient.Zoneassociation{
+							{Fqdn: "vanity1.test.com", View: "default"},
+						},
+					}),
+					resource.TestCheckResourceAttr("infoblox_shared_record_mx.mx", "mail_exchanger", "mx2.mail.test.com"),
+					resource.TestCheckResourceAttr("infoblox_shared_record_mx.mx", "preference", "20"),
+					resource.TestCheckResourceAttr("infoblox_shared_record_mx.mx", "comment", "secondary MX"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,48 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+var sharedRecordTypeTXT = &sharedRecordType{
+	title:        "shared TXT-record",
+	returnFields: []string{"text"},
+	fields: map[string]*schema.Schema{
+		"text": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Data to be associated with the record.",
+		},
+	},
+	newObject: func() ibclient.IBObject {
+		return &ibclient.SharedRecordTXT{}
+	},
+	form: func(d *schema.ResourceData, base *sharedRecordBase) (ibclient.IBObject, error) {
+		return &ibclient.SharedRecordTXT{
+			Name:              base.Name,
+			SharedRecordGroup: base.SharedRecordGroup,
+			Comment:           base.Comment,
+			Disable:           base.Disable,
+			Ttl:               base.Ttl,
+			UseTtl:            base.UseTtl,
+			Ea:                base.Ea,
+			Text:              utils.StringPtr(d.Get("text").(string)),
+		}, nil
+	},
+	set: func(d *schema.ResourceData, rec map[string]interface{}) error {
+		var obj ibclient.SharedRecordTXT
+		if err := convertWapiObject(rec, &obj); err != nil {
+			return err
+		}
+		if obj.Text != nil {
+			return d.Set("text", *obj.Text)
+		}
+		return nil
+	},
+}
+
+func resourceSharedRecordTXT() *schema.Resource {
+	return resourceSharedRecord(sharedRecordTypeTXT)
+}
@@ -25,6 +25,9 @@ func resourceSRVRecord() *schema.Resource {
 					return err
 				}
 			}
+			if err := dnsNameFormsCustomizeDiff(d, "name"); err != nil {
+				return err
+			}
 			return nil
 		},
 
@@ -36,10 +39,13 @@ func resourceSRVRecord() *schema.Resource {
 				Description: "DNS view which the zone does exist within",
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Combination of service's name, protocol's name and zone's name",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Combination of service's name, protocol's name and zone's name",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
+			"name_ascii":   dnsNameAsciiSchema("name"),
+			"name_unicode": dnsNameUnicodeSchema("name"),
 			"priority": {
 				Type:        schema.TypeInt,
 				Required:    true,
@@ -56,9 +62,10 @@ func resourceSRVRecord() *schema.Resource {
 				Description: "Configures port number (0..65535) for this SRV-record.",
 			},
 			"target": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Provides service for domain name in the SRV-record.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Provides service for domain name in the SRV-record.",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
@@ -103,11 +110,11 @@ func resourceSRVRecordCreate(d *schema.ResourceData, m interface{}) error {
 	dnsView := d.Get("dns_view").(string)
 
 	// the next group of parameters will be validated inside ibclient.CreateSRVRecord()
-	name := d.Get("name").(string)
+	name := dnsNameForWapi(d.Get("name").(string))
 	priority := d.Get("priority").(int)
 	weight := d.Get("weight").(int)
 	port := d.Get("port").(int)
-	target := d.Get("target").(string)
+	target := dnsNameForWapi(d.Get("target").(string))
 
 	var ttl uint32
 	useTtl := false
@@ -235,6 +242,10 @@ func resourceSRVRecordGet(d *schema.ResourceData, m interface{}) error {
 	if err = d.Set("target", obj.Target); err != nil {
 		return err
 	}
+	if err := setDnsNameForms(d, "name"); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
@@ -279,11 +290,11 @@ func resourceSRVRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	// the next group of parameters will be validated inside ibclient.UpdateSRVRecord()
-	name := d.Get("name").(string)
+	name := dnsNameForWapi(d.Get("name").(string))
 	priority := d.Get("priority").(int)
 	weight := d.Get("weight").(int)
 	port := d.Get("port").(int)
-	target := d.Get("target").(string)
+	target := dnsNameForWapi(d.Get("target").(string))
 
 	var ttl uint32
 	useTtl := false
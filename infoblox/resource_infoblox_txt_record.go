@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
@@ -25,6 +26,19 @@ func resourceTXTRecord() *schema.Resource {
 					return err
 				}
 			}
+			if err := dnsNameFormsCustomizeDiff(d, "fqdn"); err != nil {
+				return err
+			}
+
+			// 'text' and 'text_strings' fields represent the same data, the one which is not set follows the other.
+			if d.Id() != "" {
+				if d.HasChange("text") && !d.HasChange("text_strings") {
+					return d.SetNewComputed("text_strings")
+				}
+				if d.HasChange("text_strings") && !d.HasChange("text") {
+					return d.SetNewComputed("text")
+				}
+			}
 			return nil
 		},
 
@@ -36,14 +50,33 @@ func resourceTXTRecord() *schema.Resource {
 				Description: "DNS view in which the record's zone exists.",
 			},
 			"fqdn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "FQDN for the TXT-Record.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "FQDN for the TXT-Record.",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
+			"fqdn_ascii":   dnsNameAsciiSchema("fqdn"),
+			"fqdn_unicode": dnsNameUnicodeSchema("fqdn"),
 			"text": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Data to be associated with TXT_Record.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"text_strings"},
+				DiffSuppressFunc: suppressTxtDataDiff,
+				Description: "Data to be associated with TXT_Record. The text longer than 255 bytes" +
+					" is split into several character strings.",
+			},
+			"text_strings": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"text"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(0, txtMaxStringLen),
+				},
+				Description: "Character strings of the TXT-Record, each of them up to 255 bytes long;" +
+					" an alternative to 'text' field.",
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
@@ -86,10 +119,10 @@ func resourceTXTRecordCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	dnsView := d.Get("dns_view").(string)
-	fqdn := d.Get("fqdn").(string)
-	text := d.Get("text").(string)
-	if text == "" {
-		return fmt.Errorf("empty 'text' value is not allowed")
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
+	text, err := getTxtRecordData(d)
+	if err != nil {
+		return err
 	}
 
 	var ttl uint32
@@ -170,7 +203,7 @@ func resourceTXTRecordGet(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("failed getting TXT-Record: %s", err)
 	}
 
-	if err = d.Set("text", obj.Text); err != nil {
+	if err = setTxtRecordData(d, obj.Text); err != nil {
 		return err
 	}
 
@@ -214,6 +247,10 @@ func resourceTXTRecordGet(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if err := setDnsNameForms(d, "fqdn"); err != nil {
+		return err
+	}
+
 	d.SetId(obj.Ref)
 
 	return nil
@@ -229,6 +266,7 @@ func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			prevDNSView, _ := d.GetChange("dns_view")
 			prevFQDN, _ := d.GetChange("fqdn")
 			prevTEXT, _ := d.GetChange("text")
+			prevTextStrings, _ := d.GetChange("text_strings")
 			prevTTL, _ := d.GetChange("ttl")
 			prevComment, _ := d.GetChange("comment")
 			prevEa, _ := d.GetChange("ext_attrs")
@@ -236,6 +274,7 @@ func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("dns_view", prevDNSView.(string))
 			_ = d.Set("fqdn", prevFQDN.(string))
 			_ = d.Set("text", prevTEXT.(string))
+			_ = d.Set("text_strings", prevTextStrings.([]interface{}))
 			_ = d.Set("ttl", prevTTL.(int))
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("ext_attrs", prevEa.(string))
@@ -249,12 +288,12 @@ func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
 	}
 
-	text := d.Get("text").(string)
-	if text == "" {
-		return fmt.Errorf("empty 'text' value is not allowed")
+	text, err := getTxtRecordData(d)
+	if err != nil {
+		return err
 	}
 
-	fqdn := d.Get("fqdn").(string)
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
 
 	var ttl uint32
 	useTtl := false
@@ -389,7 +428,7 @@ func resourceTXTRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 		return nil, fmt.Errorf("failed getting TXT-Record: %s", err)
 	}
 
-	if err = d.Set("text", obj.Text); err != nil {
+	if err = setTxtRecordData(d, obj.Text); err != nil {
 		return nil, err
 	}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/infobloxopen/infoblox-go-client/v2/utils"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -451,3 +452,60 @@ func TestAcc_resourceTXTRecord_ea_inheritance(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_resourceTXTRecord_longText(t *testing.T) {
+	longText := "v=DKIM1; k=rsa; p=" + strings.Repeat("MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA", 8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTXTRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "test.com"
+					}
+					resource "infoblox_txt_record" "dkim"{
+						fqdn = "selector._domainkey.test.com"
+						text = "%s"
+						depends_on = [infoblox_zone_auth.zone]
+					}`, longText),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_txt_record.dkim", "text", longText),
+					resource.TestCheckResourceAttr("infoblox_txt_record.dkim", "text_strings.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_txt_record.dkim", "text_strings.0", longText[:txtMaxStringLen]),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "test.com"
+					}
+					resource "infoblox_txt_record" "dkim"{
+						fqdn = "selector._domainkey.test.com"
+						text_strings = ["v=DKIM1; k=rsa; ", "p=MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA"]
+						depends_on = [infoblox_zone_auth.zone]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_txt_record.dkim", "text",
+						"v=DKIM1; k=rsa; p=MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA"),
+					resource.TestCheckResourceAttr("infoblox_txt_record.dkim", "text_strings.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_txt_record.dkim", "text_strings.0", "v=DKIM1; k=rsa; "),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "test.com"
+					}
+					resource "infoblox_txt_record" "dkim"{
+						fqdn = "selector._domainkey.test.com"
+						text_strings = ["v=DKIM1; k=rsa; ", "p=MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA"]
+						depends_on = [infoblox_zone_auth.zone]
+					}`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,509 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+var unknownRecordReturnFields = []string{
+	"name",
+	"view",
+	"zone",
+	"record_type",
+	"subfield_values",
+	"display_rdata",
+	"ttl",
+	"use_ttl",
+	"comment",
+	"disable",
+	"extattrs",
+}
+
+func newEmptyUnknownRecord() *ibclient.RecordUnknown {
+	rec := &ibclient.RecordUnknown{}
+	rec.SetReturnFields(unknownRecordReturnFields)
+
+	return rec
+}
+
+func resourceUnknownRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUnknownRecordCreate,
+		Read:   resourceUnknownRecordGet,
+		Update: resourceUnknownRecordUpdate,
+		Delete: resourceUnknownRecordDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceUnknownRecordImport,
+		},
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			if err := dnsNameFormsCustomizeDiff(d, "fqdn"); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view in which the record's zone exists.",
+			},
+			"fqdn": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "FQDN for the record.",
+				DiffSuppressFunc: suppressDnsNameDiff,
+			},
+			"fqdn_ascii":   dnsNameAsciiSchema("fqdn"),
+			"fqdn_unicode": dnsNameUnicodeSchema("fqdn"),
+			"record_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				// NIOS stores the type in upper case.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				Description: "The type of the resource record, as defined in the corresponding RFC." +
+					" Examples: 'SSHFP', 'HINFO', 'URI', 'SVCB', 'HTTPS'.",
+			},
+			"subfield_values": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The list of RDATA subfields of the record, in the order they appear in the RDATA.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice(
+								[]string{"B", "S", "I", "H", "6", "4", "N", "T", "X"}, false),
+							Description: "The type of the subfield. Valid values are: 'B' (unsigned 8-bit integer)," +
+								" 'S' (unsigned 16-bit integer), 'I' (unsigned 32-bit integer), 'H' (BASE64)," +
+								" '6' (IPv6 address), '4' (IPv4 address), 'N' (domain name), 'T' (text string)," +
+								" 'X' (opaque binary data).",
+						},
+						"field_value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "String representation of the subfield's value.",
+						},
+						"include_length": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "NONE",
+							ValidateFunc: validation.StringInSlice([]string{"NONE", "8_BIT", "16_BIT"}, false),
+							Description:  "The size of 'length' sub-subfield to be included in RDATA. Valid values are: 'NONE', '8_BIT', '16_BIT'.",
+						},
+					},
+				},
+			},
+			"display_rdata": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Standard textual representation of the record's RDATA.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the zone in which the record resides.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value of the record.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if the record is disabled or not.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the record.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the record to be added/updated, as a map in JSON format",
+			},
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func convertRdataSubfieldsFromInterface(subfields []interface{}) []*ibclient.Rdatasubfield {
+	res := make([]*ibclient.Rdatasubfield, 0, len(subfields))
+	for _, sf := range subfields {
+		sfMap := sf.(map[string]interface{})
+		res = append(res, &ibclient.Rdatasubfield{
+			FieldType:     sfMap["field_type"].(string),
+			FieldValue:    sfMap["field_value"].(string),
+			IncludeLength: sfMap["include_length"].(string),
+		})
+	}
+
+	return res
+}
+
+func convertRdataSubfieldsToInterface(subfields []*ibclient.Rdatasubfield) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(subfields))
+	for _, sf := range subfields {
+		if sf == nil {
+			continue
+		}
+		includeLength := sf.IncludeLength
+		if includeLength == "" {
+			includeLength = "NONE"
+		}
+		res = append(res, map[string]interface{}{
+			"field_type":     sf.FieldType,
+			"field_value":    sf.FieldValue,
+			"include_length": includeLength,
+		})
+	}
+
+	return res
+}
+
+// formUnknownRecord builds a WAPI object out of the resource's data,
+// with the set of fields which are allowed to be sent on an update operation.
+func formUnknownRecord(d *schema.ResourceData) (*ibclient.RecordUnknown, error) {
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return nil, fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	subfields := convertRdataSubfieldsFromInterface(d.Get("subfield_values").([]interface{}))
+	if len(subfields) == 0 {
+		return nil, fmt.Errorf("at least one RDATA subfield must be defined")
+	}
+
+	rec := &ibclient.RecordUnknown{
+		Name:           utils.StringPtr(dnsNameForWapi(d.Get("fqdn").(string))),
+		SubfieldValues: subfields,
+		Ttl:            utils.Uint32Ptr(ttl),
+		UseTtl:         utils.BoolPtr(useTtl),
+		Comment:        utils.StringPtr(d.Get("comment").(string)),
+		Disable:        utils.BoolPtr(d.Get("disable").(bool)),
+	}
+
+	return rec, nil
+}
+
+func setUnknownRecordFields(d *schema.ResourceData, rec *ibclient.RecordUnknown) error {
+	ttl := ttlUndef
+	if rec.UseTtl != nil && *rec.UseTtl && rec.Ttl != nil {
+		ttl = int(*rec.Ttl)
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	if rec.Name != nil {
+		if err := d.Set("fqdn", *rec.Name); err != nil {
+			return err
+		}
+	}
+
+	if rec.View != nil {
+		if err := d.Set("dns_view", *rec.View); err != nil {
+			return err
+		}
+	}
+
+	if rec.RecordType != nil {
+		if err := d.Set("record_type", *rec.RecordType); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("subfield_values", convertRdataSubfieldsToInterface(rec.SubfieldValues)); err != nil {
+		return err
+	}
+
+	if err := d.Set("display_rdata", rec.DisplayRdata); err != nil {
+		return err
+	}
+
+	if err := d.Set("zone", rec.Zone); err != nil {
+		return err
+	}
+
+	comment := ""
+	if rec.Comment != nil {
+		comment = *rec.Comment
+	}
+	if err := d.Set("comment", comment); err != nil {
+		return err
+	}
+
+	disable := false
+	if rec.Disable != nil {
+		disable = *rec.Disable
+	}
+	if err := d.Set("disable", disable); err != nil {
+		return err
+	}
+
+	return d.Set("ref", rec.Ref)
+}
+
+func resourceUnknownRecordCreate(d *schema.ResourceData, m interface{}) error {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+	}
+
+	rec, err := formUnknownRecord(d)
+	if err != nil {
+		return err
+	}
+	rec.View = utils.StringPtr(d.Get("dns_view").(string))
+	rec.RecordType = utils.StringPtr(strings.ToUpper(d.Get("record_type").(string)))
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	rec.Ea = extAttrs
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(rec)
+	if err != nil {
+		return fmt.Errorf("error creating Unknown Record: %s", err)
+	}
+
+	d.SetId(ref)
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return err
+	}
+
+	return resourceUnknownRecordGet(d, m)
+}
+
+func resourceUnknownRecordGet(d *schema.ResourceData, m interface{}) error {
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	var rec ibclient.RecordUnknown
+	err = searchGenericObjectByRefOrInternalId(newEmptyUnknownRecord(), d, m, &rec)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if err = setUnknownRecordFields(d, &rec); err != nil {
+		return err
+	}
+
+	delete(rec.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(rec.Ea, extAttrs)
+
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return err
+		}
+	}
+
+	if err := setDnsNameForms(d, "fqdn"); err != nil {
+		return err
+	}
+
+	d.SetId(rec.Ref)
+
+	return nil
+}
+
+func resourceUnknownRecordUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			prevFQDN, _ := d.GetChange("fqdn")
+			prevSubfields, _ := d.GetChange("subfield_values")
+			prevTTL, _ := d.GetChange("ttl")
+			prevDisable, _ := d.GetChange("disable")
+			prevComment, _ := d.GetChange("comment")
+			prevEa, _ := d.GetChange("ext_attrs")
+
+			_ = d.Set("fqdn", prevFQDN.(string))
+			_ = d.Set("subfield_values", prevSubfields)
+			_ = d.Set("ttl", prevTTL.(int))
+			_ = d.Set("disable", prevDisable.(bool))
+			_ = d.Set("comment", prevComment.(string))
+			_ = d.Set("ext_attrs", prevEa.(string))
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+	}
+	if d.HasChange("dns_view") {
+		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+	}
+	if d.HasChange("record_type") {
+		return fmt.Errorf("changing the value of 'record_type' field is not allowed")
+	}
+
+	rec, err := formUnknownRecord(d)
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
+
+	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	var currentRec ibclient.RecordUnknown
+	err = searchGenericObjectByRefOrInternalId(newEmptyUnknownRecord(), d, m, &currentRec)
+	if err != nil {
+		return fmt.Errorf("failed to read Unknown Record for update operation: %w", err)
+	}
+
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	rec.Ea, err = mergeEAs(currentRec.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return err
+	}
+
+	ref, err := connector.UpdateObject(rec, currentRec.Ref)
+	if err != nil {
+		return fmt.Errorf("error updating Unknown Record: %s", err)
+	}
+	updateSuccessful = true
+	d.SetId(ref)
+
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return err
+	}
+
+	return resourceUnknownRecordGet(d, m)
+}
+
+func resourceUnknownRecordDelete(d *schema.ResourceData, m interface{}) error {
+	var rec ibclient.RecordUnknown
+	err := searchGenericObjectByRefOrInternalId(newEmptyUnknownRecord(), d, m, &rec)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	connector := m.(ibclient.IBConnector)
+	if _, err = connector.DeleteObject(rec.Ref); err != nil {
+		return fmt.Errorf("deletion of Unknown Record failed: %s", err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceUnknownRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(ibclient.IBConnector)
+
+	var rec ibclient.RecordUnknown
+	if err := connector.GetObject(newEmptyUnknownRecord(), d.Id(), ibclient.NewQueryParams(false, nil), &rec); err != nil {
+		return nil, fmt.Errorf("failed getting Unknown Record: %s", err)
+	}
+
+	if err := setUnknownRecordFields(d, &rec); err != nil {
+		return nil, err
+	}
+
+	if rec.Ea != nil && len(rec.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(rec.Ea)
+		if err != nil {
+			return nil, err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(rec.Ref)
+
+	if err := resourceUnknownRecordUpdate(d, m); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,206 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+func testAccCheckUnknownRecordDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_unknown_record" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var rec ibclient.RecordUnknown
+		err := connector.GetObject(newEmptyUnknownRecord(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &rec)
+		if err == nil && rec.Ref != "" {
+			return fmt.Errorf("record still exists")
+		}
+	}
+	return nil
+}
+
+func testAccUnknownRecordCompare(t *testing.T, resPath string, expectedRec *ibclient.RecordUnknown) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		internalId := res.Primary.Attributes["internal_id"]
+		if internalId == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		ref, found := res.Primary.Attributes["ref"]
+		if !found {
+			return fmt.Errorf("'ref' attribute is not set")
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		var rec ibclient.RecordUnknown
+		err := connector.GetObject(newEmptyUnknownRecord(), ref, ibclient.NewQueryParams(false, nil), &rec)
+		if err != nil {
+			if isNotFoundError(err) {
+				if expectedRec == nil {
+					return nil
+				}
+				return fmt.Errorf("object with Terraform ID '%s' not found, but expected to exist", internalId)
+			}
+			return err
+		}
+
+		if *rec.Name != *expectedRec.Name {
+			return fmt.Errorf(
+				"'fqdn' does not match: got '%s', expected '%s'",
+				*rec.Name, *expectedRec.Name)
+		}
+
+		if *rec.View != *expectedRec.View {
+			return fmt.Errorf(
+				"'dns_view' does not match: got '%s', expected '%s'",
+				*rec.View, *expectedRec.View)
+		}
+
+		if *rec.RecordType != *expectedRec.RecordType {
+			return fmt.Errorf(
+				"'record_type' does not match: got '%s', expected '%s'",
+				*rec.RecordType, *expectedRec.RecordType)
+		}
+
+		if len(rec.SubfieldValues) != len(expectedRec.SubfieldValues) {
+			return fmt.Errorf(
+				"the number of RDATA subfields does not match: got '%d', expected '%d'",
+				len(rec.SubfieldValues), len(expectedRec.SubfieldValues))
+		}
+		for i, sf := range rec.SubfieldValues {
+			expSf := expectedRec.SubfieldValues[i]
+			if sf.FieldType != expSf.FieldType || sf.FieldValue != expSf.FieldValue {
+				return fmt.Errorf(
+					"RDATA subfield #%d does not match: got '%s:%s', expected '%s:%s'",
+					i, sf.FieldType, sf.FieldValue, expSf.FieldType, expSf.FieldValue)
+			}
+		}
+
+		if *rec.UseTtl != *expectedRec.UseTtl {
+			return fmt.Errorf(
+				"'use_ttl' does not match: got '%t', expected '%t'",
+				*rec.UseTtl, *expectedRec.UseTtl)
+		}
+		if *rec.UseTtl && *rec.Ttl != *expectedRec.Ttl {
+			return fmt.Errorf(
+				"'ttl' does not match: got '%d', expected '%d'",
+				*rec.Ttl, *expectedRec.Ttl)
+		}
+
+		if expectedRec.Comment != nil {
+			if rec.Comment == nil || *rec.Comment != *expectedRec.Comment {
+				return fmt.Errorf("'comment' does not match the expected value '%s'", *expectedRec.Comment)
+			}
+		}
+
+		return validateEAs(rec.Ea, expectedRec.Ea)
+	}
+}
+
+func TestAccResourceUnknownRecord(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckUnknownRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "unknown.test.com"
+					}
+					resource "infoblox_unknown_record" "sshfp" {
+						fqdn = "host1.unknown.test.com"
+						record_type = "SSHFP"
+						subfield_values {
+							field_type = "B"
+							field_value = "1"
+						}
+						subfield_values {
+							field_type = "B"
+							field_value = "1"
+						}
+						subfield_values {
+							field_type = "X"
+							field_value = "123456789ABCDEF67890123456789ABCDEF67890"
+						}
+						depends_on = [infoblox_zone_auth.zone]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccUnknownRecordCompare(t, "infoblox_unknown_record.sshfp", &ibclient.RecordUnknown{
+						Name:       utils.StringPtr("host1.unknown.test.com"),
+						View:       utils.StringPtr("default"),
+						RecordType: utils.StringPtr("SSHFP"),
+						SubfieldValues: []*ibclient.Rdatasubfield{
+							{FieldType: "B", FieldValue: "1"},
+							{FieldType: "B", FieldValue: "1"},
+							{FieldType: "X", FieldValue: "123456789ABCDEF67890123456789ABCDEF67890"},
+						},
+						UseTtl: utils.BoolPtr(false),
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "unknown.test.com"
+					}
+					resource "infoblox_unknown_record" "sshfp" {
+						fqdn = "host1.unknown.test.com"
+						record_type = "sshfp"
+						subfield_values {
+							field_type = "B"
+							field_value = "4"
+						}
+						subfield_values {
+							field_type = "B"
+							field_value = "2"
+						}
+						subfield_values {
+							field_type = "X"
+							field_value = "123456789ABCDEF67890123456789ABCDEF67890123456789ABCDEF123456"
+						}
+						ttl = 300
+						comment = "SSH fingerprint"
+						ext_attrs = jsonencode({
+							"Location" = "California"
+						})
+						depends_on = [infoblox_zone_auth.zone]
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccUnknownRecordCompare(t, "infoblox_unknown_record.sshfp", &ibclient.RecordUnknown{
+						Name:       utils.StringPtr("host1.unknown.test.com"),
+						View:       utils.StringPtr("default"),
+						RecordType: utils.StringPtr("SSHFP"),
+						SubfieldValues: []*ibclient.Rdatasubfield{
+							{FieldType: "B", FieldValue: "4"},
+							{FieldType: "B", FieldValue: "2"},
+							{FieldType: "X", FieldValue: "123456789ABCDEF67890123456789ABCDEF67890123456789ABCDEF123456"},
+						},
+						Ttl:     utils.Uint32Ptr(300),
+						UseTtl:  utils.BoolPtr(true),
+						Comment: utils.StringPtr("SSH fingerprint"),
+						Ea: ibclient.EA{
+							"Location": "California",
+						},
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,15 +2,131 @@ package infoblox
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/infobloxopen/infoblox-go-client/v2/utils"
 
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
 
+var zoneAuthReturnFields = []string{
+	"fqdn",
+	"dns_fqdn",
+	"view",
+	"zone_format",
+	"comment",
+	"ns_group",
+	"soa_default_ttl",
+	"soa_expire",
+	"soa_negative_ttl",
+	"soa_refresh",
+	"soa_retry",
+	"dnssec_key_params",
+	"use_dnssec_key_params",
+	"dnssec_keys",
+	"is_dnssec_signed",
+	"allow_query",
+	"use_allow_query",
+	"allow_transfer",
+	"use_allow_transfer",
+	"allow_update",
+	"use_allow_update",
+	"update_forwarding",
+	"allow_update_forwarding",
+	"use_allow_update_forwarding",
+	"grid_primary",
+	"grid_secondaries",
+	"external_primaries",
+	"external_secondaries",
+	"use_external_primary",
+	"extattrs",
+}
+
+func newEmptyZoneAuth() *ibclient.ZoneAuth {
+	zone := &ibclient.ZoneAuth{}
+	zone.SetReturnFields(zoneAuthReturnFields)
+
+	return zone
+}
+
+// zoneAuth extends ibclient.ZoneAuth with the access control lists in the form NIOS accepts and returns them,
+// which the client's struct cannot represent (a mix of addresses, TSIG keys and named ACLs),
+// and with the lists of name servers, which the client's struct cannot clear.
+type zoneAuth struct {
+	ibclient.ZoneAuth
+	AllowQuery          *aclList                  `json:"allow_query,omitempty"`
+	AllowTransfer       *aclList                  `json:"allow_transfer,omitempty"`
+	AllowUpdate         *aclList                  `json:"allow_update,omitempty"`
+	UpdateForwarding    *aclList                  `json:"update_forwarding,omitempty"`
+	GridPrimary         *[]*ibclient.Memberserver `json:"grid_primary,omitempty"`
+	GridSecondaries     *[]*ibclient.Memberserver `json:"grid_secondaries,omitempty"`
+	ExternalPrimaries   *[]ibclient.NameServer    `json:"external_primaries,omitempty"`
+	ExternalSecondaries *[]ibclient.NameServer    `json:"external_secondaries,omitempty"`
+}
+
+// zoneAuthNameServerFields lists the fields which assign name servers to the zone directly,
+// as opposed to the assignment via a name server group.
+var zoneAuthNameServerFields = []string{
+	"grid_primary",
+	"grid_secondaries",
+	"external_primaries",
+	"external_secondaries",
+}
+
+// gridSecondarySchema describes a Grid member which serves a zone as a secondary server.
+var gridSecondarySchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the Grid member in FQDN format.",
+		},
+		"stealth": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Determines if NS and glue records for the member are hidden.",
+		},
+		"lead": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Determines if the member is the lead secondary, which transfers the zone from external primaries.",
+		},
+		"grid_replicate": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Determines if the zone is replicated to the member by the Grid, instead of zone transfers.",
+		},
+	},
+}
+
+// externalServerSchema describes an external DNS server which serves a zone.
+var externalServerSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"address": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The IP address of the server.",
+		},
+		"name": {
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppressDnsNameDiff,
+			Description:      "The name of the server.",
+		},
+		"stealth": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Determines if NS records for the server are hidden.",
+		},
+	},
+}
+
 func resourceZoneAuth() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceZoneAuthCreate,
@@ -27,6 +143,26 @@ func resourceZoneAuth() *schema.Resource {
 					return err
 				}
 			}
+			if err := dnsNameFormsCustomizeDiff(d, "fqdn"); err != nil {
+				return err
+			}
+			if d.Get("ns_group").(string) != "" {
+				for _, field := range zoneAuthNameServerFields {
+					if len(d.Get(field).([]interface{})) > 0 {
+						return fmt.Errorf("'%s' must not be set together with 'ns_group'", field)
+					}
+				}
+			}
+			// Signing a zone and changing its signing keys generate new keys
+			// and so new DS records for the parent zone.
+			if d.HasChange("dnssec_signed") || d.HasChange("dnssec_key_params") {
+				if err := d.SetNewComputed("dnssec_keys"); err != nil {
+					return err
+				}
+				if err := d.SetNewComputed("dnssec_ds_records"); err != nil {
+					return err
+				}
+			}
 			return nil
 		},
 
@@ -38,7 +174,10 @@ func resourceZoneAuth() *schema.Resource {
 					"format. For other zones, this is in FQDN format. This value can be in " +
 					"unicode format. Note that for a reverse zone, the corresponding zone_format " +
 					"value should be set.",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
+			"fqdn_ascii":   dnsNameAsciiSchema("fqdn"),
+			"fqdn_unicode": dnsNameUnicodeSchema("fqdn"),
 
 			"view": {
 				Type:        schema.TypeString,
@@ -72,6 +211,34 @@ func resourceZoneAuth() *schema.Resource {
 				Description: "The name server group that serves DNS for this zone.",
 			},
 
+			"grid_primary": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The Grid primary servers of the zone; must not be set together with 'ns_group'.",
+				Elem:        memberServerSchema,
+			},
+
+			"grid_secondaries": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The Grid secondary servers of the zone; must not be set together with 'ns_group'.",
+				Elem:        gridSecondarySchema,
+			},
+
+			"external_primaries": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The external primary servers of the zone; must not be set together with 'ns_group'.",
+				Elem:        externalServerSchema,
+			},
+
+			"external_secondaries": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The external secondary servers of the zone; must not be set together with 'ns_group'.",
+				Elem:        externalServerSchema,
+			},
+
 			"restart_if_needed": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -120,6 +287,140 @@ func resourceZoneAuth() *schema.Resource {
 					"recontact the primary server after a connection failure between the two " +
 					"servers occurs.",
 			},
+
+			"allow_query": aclSchema("The access control list of the clients which are allowed to query the zone. " +
+				"The Grid's or the view's settings are used if the list is not set."),
+
+			"allow_transfer": aclSchema("The access control list of the clients which are allowed " +
+				"to transfer the zone. The Grid's or the view's settings are used if the list is not set."),
+
+			"allow_update": aclSchema("The access control list of the clients which are allowed to send " +
+				"dynamic DNS updates for the zone. The Grid's or the view's settings are used if the list is not set."),
+
+			"update_forwarding": aclSchema("The access control list of the clients whose dynamic DNS updates " +
+				"are forwarded to the primary server of the zone. Update forwarding is disabled if the list is not set."),
+
+			"dnssec_signed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				Description: "Determines whether the zone is signed with DNSSEC. Setting this to 'true' signs the zone, " +
+					"setting it to 'false' unsigns it; the zone is left as is, if the value is not set.",
+			},
+
+			"dnssec_key_params": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Description: "The DNSSEC key parameters of the zone, which override the Grid's ones. " +
+					"The Grid's parameters are used if the block is not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ksk_algorithms": dnssecKeyAlgorithmsSchema("The algorithms and sizes of the key-signing keys (KSK)."),
+						"ksk_rollover": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The rollover interval of the KSKs, in seconds.",
+						},
+						"enable_ksk_auto_rollover": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							Description: "Determines whether the KSKs are rolled over automatically.",
+						},
+						"zsk_algorithms": dnssecKeyAlgorithmsSchema("The algorithms and sizes of the zone-signing keys (ZSK)."),
+						"zsk_rollover": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The rollover interval of the ZSKs, in seconds.",
+						},
+						"zsk_rollover_mechanism": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PRE_PUBLISH", "DOUBLE_SIGN"}, false),
+							Description:  "The ZSK rollover mechanism: PRE_PUBLISH or DOUBLE_SIGN.",
+						},
+						"next_secure_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"NSEC", "NSEC3"}, false),
+							Description:  "The type of the authenticated denial of existence records: NSEC or NSEC3.",
+						},
+						"nsec3_iterations": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The number of iterations used for hashing NSEC3.",
+						},
+						"nsec3_salt_min_length": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The minimum length of the NSEC3 salt, in octets.",
+						},
+						"nsec3_salt_max_length": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The maximum length of the NSEC3 salt, in octets.",
+						},
+						"signature_expiration": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The signature expiration time, in seconds.",
+						},
+					},
+				},
+			},
+
+			"dnssec_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The DNSSEC keys of the zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The tag of the key.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the key: KSK or ZSK.",
+						},
+						"algorithm": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The algorithm of the key.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the key.",
+						},
+						"public_key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Base64 encoding of the public key.",
+						},
+					},
+				},
+			},
+
+			"dnssec_ds_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The DS records (SHA-256) of the active and pre-published KSKs of the zone, " +
+					"which are to be published in the parent zone; may be passed to 'ds_records' of 'infoblox_zone_delegated'.",
+			},
+
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -147,7 +448,7 @@ func checkZoneFormat(f string) diag.Diagnostics {
 
 func formZone(
 	create bool, d *schema.ResourceData, m interface{}) (
-	*ibclient.ZoneAuth, diag.Diagnostics) {
+	*zoneAuth, diag.Diagnostics) {
 
 	extAttrJSON := d.Get("ext_attrs").(string)
 	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
@@ -155,12 +456,14 @@ func formZone(
 		return nil, diag.FromErr(err)
 	}
 
-	zone := &ibclient.ZoneAuth{
-		Ea: extAttrs,
+	zone := &zoneAuth{
+		ZoneAuth: ibclient.ZoneAuth{
+			Ea: extAttrs,
+		},
 	}
 
 	if create {
-		zone.Fqdn = d.Get("fqdn").(string)
+		zone.Fqdn = dnsNameForWapi(d.Get("fqdn").(string))
 
 		zone.View = utils.StringPtr(d.Get("view").(string))
 		if *zone.View == "" {
@@ -189,6 +492,31 @@ func formZone(
 		zone.NsGroup = nil
 	}
 
+	if nsGrp == "" && (create || d.HasChanges(zoneAuthNameServerFields...)) {
+		gridPrimary := convertMemberServersFromInterface(d.Get("grid_primary").([]interface{}))
+		gridSecondaries := convertMemberServersFromInterface(d.Get("grid_secondaries").([]interface{}))
+		externalPrimaries, err := validateNameServers(d.Get("external_primaries").([]interface{}))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		externalSecondaries, err := validateNameServers(d.Get("external_secondaries").([]interface{}))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if externalPrimaries == nil {
+			externalPrimaries = []ibclient.NameServer{}
+		}
+		if externalSecondaries == nil {
+			externalSecondaries = []ibclient.NameServer{}
+		}
+
+		zone.GridPrimary = &gridPrimary
+		zone.GridSecondaries = &gridSecondaries
+		zone.ExternalPrimaries = &externalPrimaries
+		zone.ExternalSecondaries = &externalSecondaries
+		zone.UseExternalPrimary = utils.BoolPtr(len(externalPrimaries) > 0)
+	}
+
 	if d.HasChange("restart_if_needed") {
 		zone.RestartIfNeeded = utils.BoolPtr(d.Get("restart_if_needed").(bool))
 	}
@@ -213,9 +541,302 @@ func formZone(
 		zone.SoaRetry = utils.Uint32Ptr(uint32(d.Get("soa_retry").(int)))
 	}
 
+	if d.HasChange("dnssec_key_params") {
+		keyParams := d.Get("dnssec_key_params").([]interface{})
+		zone.UseDnssecKeyParams = utils.BoolPtr(len(keyParams) > 0)
+		if len(keyParams) > 0 {
+			zone.DnssecKeyParams = convertDnssecKeyParamsFromInterface(keyParams[0].(map[string]interface{}))
+		}
+	}
+
+	connector := m.(ibclient.IBConnector)
+	acls := []struct {
+		field string
+		list  **aclList
+		use   **bool
+	}{
+		{"allow_query", &zone.AllowQuery, &zone.UseAllowQuery},
+		{"allow_transfer", &zone.AllowTransfer, &zone.UseAllowTransfer},
+		{"allow_update", &zone.AllowUpdate, &zone.UseAllowUpdate},
+		{"update_forwarding", &zone.UpdateForwarding, &zone.UseAllowUpdateForwarding},
+	}
+	for _, acl := range acls {
+		if !d.HasChange(acl.field) {
+			continue
+		}
+		entries, err := convertAclFromInterface(connector, d.Get(acl.field).([]interface{}))
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("invalid value of '%s': %w", acl.field, err))
+		}
+		list := aclList(entries)
+		*acl.list = &list
+		*acl.use = utils.BoolPtr(len(entries) > 0)
+		if acl.field == "update_forwarding" {
+			zone.AllowUpdateForwarding = utils.BoolPtr(len(entries) > 0)
+		}
+	}
+
 	return zone, nil
 }
 
+// setZoneAuthNameServerFields sets the name servers which are assigned to the zone directly;
+// the lists are empty if the zone is served by a name server group.
+func setZoneAuthNameServerFields(d *schema.ResourceData, zone *zoneAuth) error {
+	var (
+		gridPrimary, gridSecondaries           []*ibclient.Memberserver
+		externalPrimaries, externalSecondaries []ibclient.NameServer
+	)
+	if zone.NsGroup == nil || *zone.NsGroup == "" {
+		if zone.GridPrimary != nil {
+			gridPrimary = *zone.GridPrimary
+		}
+		if zone.GridSecondaries != nil {
+			gridSecondaries = *zone.GridSecondaries
+		}
+		if zone.ExternalPrimaries != nil {
+			externalPrimaries = *zone.ExternalPrimaries
+		}
+		if zone.ExternalSecondaries != nil {
+			externalSecondaries = *zone.ExternalSecondaries
+		}
+	}
+
+	if err := d.Set("grid_primary", convertMemberServersToInterface(gridPrimary)); err != nil {
+		return err
+	}
+	if err := d.Set("grid_secondaries", convertGridSecondariesToInterface(gridSecondaries)); err != nil {
+		return err
+	}
+	if err := d.Set("external_primaries", convertExternalServersToInterface(externalPrimaries)); err != nil {
+		return err
+	}
+
+	return d.Set("external_secondaries", convertExternalServersToInterface(externalSecondaries))
+}
+
+// convertGridSecondariesToInterface converts the Grid secondary servers of a zone to the form of the resource.
+func convertGridSecondariesToInterface(members []*ibclient.Memberserver) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(members))
+	for _, member := range members {
+		if member == nil {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"name":           member.Name,
+			"stealth":        member.Stealth,
+			"lead":           member.Lead,
+			"grid_replicate": member.GridReplicate,
+		})
+	}
+
+	return res
+}
+
+// convertExternalServersToInterface converts the external servers of a zone to the form of the resource.
+func convertExternalServersToInterface(servers []ibclient.NameServer) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(servers))
+	for _, ns := range servers {
+		res = append(res, map[string]interface{}{
+			"address": ns.Address,
+			"name":    ns.Name,
+			"stealth": ns.Stealth,
+		})
+	}
+
+	return res
+}
+
+// flattenZoneAuthAcls returns the access control lists of the zone by the names of the fields;
+// a list is empty if the zone inherits the settings.
+func flattenZoneAuthAcls(zone *zoneAuth) (map[string]interface{}, error) {
+	acls := []struct {
+		field string
+		list  *aclList
+		use   *bool
+	}{
+		{"allow_query", zone.AllowQuery, zone.UseAllowQuery},
+		{"allow_transfer", zone.AllowTransfer, zone.UseAllowTransfer},
+		{"allow_update", zone.AllowUpdate, zone.UseAllowUpdate},
+		{"update_forwarding", zone.UpdateForwarding, zone.AllowUpdateForwarding},
+	}
+
+	res := make(map[string]interface{}, len(acls))
+	for _, acl := range acls {
+		var entries []interface{}
+		if acl.list != nil && acl.use != nil && *acl.use {
+			var err error
+			if entries, err = convertAclToInterface(*acl.list); err != nil {
+				return nil, fmt.Errorf("failed to read '%s': %w", acl.field, err)
+			}
+		}
+		res[acl.field] = entries
+	}
+
+	return res, nil
+}
+
+func dnssecKeyAlgorithmsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"algorithm": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(dnssecSigningAlgorithms, false),
+					Description:  "The signing algorithm.",
+				},
+				"size": {
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "The size of the key, in bits.",
+				},
+			},
+		},
+	}
+}
+
+func convertDnssecKeyAlgorithmsFromInterface(algorithms []interface{}) []*ibclient.Dnsseckeyalgorithm {
+	res := make([]*ibclient.Dnsseckeyalgorithm, 0, len(algorithms))
+	for _, a := range algorithms {
+		alg := a.(map[string]interface{})
+		res = append(res, &ibclient.Dnsseckeyalgorithm{
+			Algorithm: alg["algorithm"].(string),
+			Size:      uint32(alg["size"].(int)),
+		})
+	}
+
+	return res
+}
+
+func convertDnssecKeyAlgorithmsToInterface(algorithms []*ibclient.Dnsseckeyalgorithm) []interface{} {
+	res := make([]interface{}, 0, len(algorithms))
+	for _, alg := range algorithms {
+		if alg == nil {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"algorithm": alg.Algorithm,
+			"size":      int(alg.Size),
+		})
+	}
+
+	return res
+}
+
+func convertDnssecKeyParamsFromInterface(params map[string]interface{}) *ibclient.Dnsseckeyparams {
+	return &ibclient.Dnsseckeyparams{
+		KskAlgorithms:         convertDnssecKeyAlgorithmsFromInterface(params["ksk_algorithms"].([]interface{})),
+		KskRollover:           uint32(params["ksk_rollover"].(int)),
+		EnableKskAutoRollover: params["enable_ksk_auto_rollover"].(bool),
+		ZskAlgorithms:         convertDnssecKeyAlgorithmsFromInterface(params["zsk_algorithms"].([]interface{})),
+		ZskRollover:           uint32(params["zsk_rollover"].(int)),
+		ZskRolloverMechanism:  params["zsk_rollover_mechanism"].(string),
+		NextSecureType:        params["next_secure_type"].(string),
+		Nsec3Iterations:       uint32(params["nsec3_iterations"].(int)),
+		Nsec3SaltMinLength:    uint32(params["nsec3_salt_min_length"].(int)),
+		Nsec3SaltMaxLength:    uint32(params["nsec3_salt_max_length"].(int)),
+		SignatureExpiration:   uint32(params["signature_expiration"].(int)),
+	}
+}
+
+func convertDnssecKeyParamsToInterface(params *ibclient.Dnsseckeyparams) []interface{} {
+	if params == nil {
+		return nil
+	}
+
+	kskAlgorithms := params.KskAlgorithms
+	if len(kskAlgorithms) == 0 && params.KskAlgorithm != "" {
+		kskAlgorithms = []*ibclient.Dnsseckeyalgorithm{{Algorithm: params.KskAlgorithm, Size: params.KskSize}}
+	}
+	zskAlgorithms := params.ZskAlgorithms
+	if len(zskAlgorithms) == 0 && params.ZskAlgorithm != "" {
+		zskAlgorithms = []*ibclient.Dnsseckeyalgorithm{{Algorithm: params.ZskAlgorithm, Size: params.ZskSize}}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"ksk_algorithms":           convertDnssecKeyAlgorithmsToInterface(kskAlgorithms),
+			"ksk_rollover":             int(params.KskRollover),
+			"enable_ksk_auto_rollover": params.EnableKskAutoRollover,
+			"zsk_algorithms":           convertDnssecKeyAlgorithmsToInterface(zskAlgorithms),
+			"zsk_rollover":             int(params.ZskRollover),
+			"zsk_rollover_mechanism":   params.ZskRolloverMechanism,
+			"next_secure_type":         params.NextSecureType,
+			"nsec3_iterations":         int(params.Nsec3Iterations),
+			"nsec3_salt_min_length":    int(params.Nsec3SaltMinLength),
+			"nsec3_salt_max_length":    int(params.Nsec3SaltMaxLength),
+			"signature_expiration":     int(params.SignatureExpiration),
+		},
+	}
+}
+
+func convertDnssecKeysToInterface(keys []*ibclient.Dnsseckey) []interface{} {
+	res := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		if key == nil {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"tag":        int(key.Tag),
+			"type":       key.Type,
+			"algorithm":  key.Algorithm,
+			"status":     key.Status,
+			"public_key": key.PublicKey,
+		})
+	}
+
+	return res
+}
+
+// setZoneAuthDnssecFields sets the DNSSEC-related fields of the resource.
+func setZoneAuthDnssecFields(d *schema.ResourceData, zone *ibclient.ZoneAuth) error {
+	if err := d.Set("dnssec_signed", zone.IsDnssecSigned); err != nil {
+		return err
+	}
+
+	var keyParams []interface{}
+	if zone.UseDnssecKeyParams != nil && *zone.UseDnssecKeyParams {
+		keyParams = convertDnssecKeyParamsToInterface(zone.DnssecKeyParams)
+	}
+	if err := d.Set("dnssec_key_params", keyParams); err != nil {
+		return err
+	}
+
+	if err := d.Set("dnssec_keys", convertDnssecKeysToInterface(zone.DnssecKeys)); err != nil {
+		return err
+	}
+
+	zoneName := zone.DnsFqdn
+	if zoneName == "" {
+		zoneName = zone.Fqdn
+	}
+	dsRecords, err := dsRecordsFromKeys(zoneName, zone.DnssecKeys)
+	if err != nil {
+		return fmt.Errorf("failed to calculate DS records of the zone: %w", err)
+	}
+
+	return d.Set("dnssec_ds_records", dsRecords)
+}
+
+// dnssecSignZone signs or unsigns the zone with the given reference.
+func dnssecSignZone(connector ibclient.IBConnector, ref string, sign bool) error {
+	operation := "UNSIGN"
+	if sign {
+		operation = "SIGN"
+	}
+
+	err := callWapiFunction(connector, ref, "dnssec_operation", map[string]string{"operation": operation}, nil)
+	if err != nil {
+		return fmt.Errorf("DNSSEC operation %s failed: %w", operation, err)
+	}
+
+	return nil
+}
+
 func resourceZoneAuthCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if intId := d.Get("internal_id"); intId.(string) != "" {
@@ -237,6 +858,12 @@ func resourceZoneAuthCreate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(fmt.Errorf("failed to create a zone: %w", err))
 	}
 
+	if d.Get("dnssec_signed").(bool) {
+		if err = dnssecSignZone(connector, zoneRef, true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if err = d.Set("ref", zoneRef); err != nil {
 		return diag.FromErr(err)
 	}
@@ -259,7 +886,8 @@ func resourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	var diags diag.Diagnostics
 
-	rec, err := searchObjectByRefOrInternalId("ZoneAuth", d, m)
+	var zoneResult *zoneAuth
+	err = searchGenericObjectByRefOrInternalId(newEmptyZoneAuth(), d, m, &zoneResult)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
 			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
@@ -270,15 +898,6 @@ func resourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 	}
 
-	// Assertion of object type and error handling
-	var zoneResult *ibclient.ZoneAuth
-	recJson, _ := json.Marshal(rec)
-	err = json.Unmarshal(recJson, &zoneResult)
-
-	if err != nil && zoneResult.Ref != "" {
-		return diag.FromErr(fmt.Errorf("getting DNS View with ID: %s failed: %w", d.Id(), err))
-	}
-
 	err = d.Set("fqdn", zoneResult.Fqdn)
 	if err != nil {
 		return diag.FromErr(err)
@@ -347,6 +966,24 @@ func resourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
+	if err = setZoneAuthNameServerFields(d, zoneResult); err != nil {
+		return diag.FromErr(err)
+	}
+
+	acls, err := flattenZoneAuthAcls(zoneResult)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for field, entries := range acls {
+		if err = d.Set(field, entries); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err = setZoneAuthDnssecFields(d, &zoneResult.ZoneAuth); err != nil {
+		return diag.FromErr(err)
+	}
+
 	delete(zoneResult.Ea, eaNameForInternalId)
 
 	omittedEAs := omitEAs(zoneResult.Ea, extAttrs)
@@ -361,6 +998,10 @@ func resourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 	}
 
+	if err := setDnsNameForms(d, "fqdn"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(zoneResult.Ref)
 	if err = d.Set("ref", zoneResult.Ref); err != nil {
 		return diag.FromErr(err)
@@ -405,7 +1046,8 @@ func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	connector := m.(ibclient.IBConnector)
 
-	rec, err := searchObjectByRefOrInternalId("ZoneAuth", d, m)
+	var zoneVal *zoneAuth
+	err = searchGenericObjectByRefOrInternalId(newEmptyZoneAuth(), d, m, &zoneVal)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
 			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
@@ -416,15 +1058,6 @@ func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		}
 	}
 
-	// Assertion of object type and error handling
-	var zoneVal *ibclient.ZoneAuth
-	recJson, _ := json.Marshal(rec)
-	err = json.Unmarshal(recJson, &zoneVal)
-
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read Zone Auth for update operation: %w", err))
-	}
-
 	internalId := d.Get("internal_id").(string)
 
 	if internalId == "" {
@@ -444,6 +1077,12 @@ func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(fmt.Errorf("failed to update a zone: %w", err))
 	}
 
+	if d.HasChange("dnssec_signed") {
+		if err = dnssecSignZone(connector, zoneRef, d.Get("dnssec_signed").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	d.SetId(zoneRef)
 
 	if err = d.Set("ref", zoneRef); err != nil {
@@ -458,7 +1097,8 @@ func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m inter
 func resourceZoneAuthDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := m.(ibclient.IBConnector)
 
-	rec, err := searchObjectByRefOrInternalId("ZoneAuth", d, m)
+	var zoneResult *zoneAuth
+	err := searchGenericObjectByRefOrInternalId(newEmptyZoneAuth(), d, m, &zoneResult)
 	if err != nil {
 		if _, ok := err.(*ibclient.NotFoundError); !ok {
 			return diag.FromErr(ibclient.NewNotFoundError(fmt.Sprintf(
@@ -469,15 +1109,6 @@ func resourceZoneAuthDelete(ctx context.Context, d *schema.ResourceData, m inter
 		}
 	}
 
-	// Assertion of object type and error handling
-	var zoneResult *ibclient.ZoneAuth
-	recJson, _ := json.Marshal(rec)
-	err = json.Unmarshal(recJson, &zoneResult)
-
-	if err != nil && zoneResult.Ref != "" {
-		return diag.FromErr(fmt.Errorf("getting zone with ID: %s failed: %w", d.Id(), err))
-	}
-
 	if _, err := connector.DeleteObject(zoneResult.Ref); err != nil {
 		return diag.FromErr(err)
 	}
@@ -497,24 +1128,9 @@ func resourceZoneAuthImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 
 	zoneRef := d.Id()
 
-	zoneResult := ibclient.ZoneAuth{}
+	zoneResult := zoneAuth{}
 
-	zone := &ibclient.ZoneAuth{}
-	zone.SetReturnFields(append(
-		zoneResult.ReturnFields(),
-		"comment",
-		"ns_group",
-		"soa_default_ttl",
-		"soa_expire",
-		"soa_negative_ttl",
-		"soa_refresh",
-		"soa_retry",
-		"view",
-		"zone_format",
-		"extattrs",
-	))
-
-	err = connector.GetObject(zone, zoneRef, nil, &zoneResult)
+	err = connector.GetObject(newEmptyZoneAuth(), zoneRef, nil, &zoneResult)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read zone: %w", err)
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+	"regexp"
 	"testing"
 )
 
@@ -634,3 +635,222 @@ func TestAcc_resourceZoneAuth_ea_inheritance(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceZoneAuthDnssec(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneAuthDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_dns_view" "view" {
+						name = "nondefault_view"
+					}
+					resource "infoblox_zone_auth" "parent" {
+						fqdn = "dnssec-parent.com"
+						ns_group = "nsgroup1"
+						dnssec_signed = true
+					}
+					resource "infoblox_zone_auth" "child" {
+						fqdn = "child.dnssec-parent.com"
+						view = infoblox_dns_view.view.name
+						ns_group = "nsgroup1"
+						dnssec_signed = true
+						dnssec_key_params {
+							ksk_algorithms {
+								algorithm = "RSASHA256"
+								size = 2048
+							}
+							zsk_algorithms {
+								algorithm = "RSASHA256"
+								size = 1024
+							}
+							ksk_rollover = 31536000
+							zsk_rollover = 2592000
+							next_secure_type = "NSEC3"
+						}
+					}
+					resource "infoblox_zone_delegated" "child" {
+						fqdn = "child.dnssec-parent.com"
+						delegate_to {
+							name = "ns1.child.dnssec-parent.com"
+							address = "10.0.0.1"
+						}
+						ds_records = infoblox_zone_auth.child.dnssec_ds_records
+						depends_on = [infoblox_zone_auth.parent]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_auth.parent", "dnssec_signed", "true"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.child", "dnssec_signed", "true"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.child", "dnssec_key_params.0.next_secure_type", "NSEC3"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.child", "dnssec_key_params.0.ksk_algorithms.0.algorithm", "RSASHA256"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.child", "dnssec_key_params.0.ksk_algorithms.0.size", "2048"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.child", "dnssec_key_params.0.zsk_algorithms.0.size", "1024"),
+					resource.TestCheckResourceAttrSet("infoblox_zone_auth.child", "dnssec_ds_records.0"),
+					resource.TestCheckResourceAttrPair(
+						"infoblox_zone_delegated.child", "ds_records.0",
+						"infoblox_zone_auth.child", "dnssec_ds_records.0"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_dns_view" "view" {
+						name = "nondefault_view"
+					}
+					resource "infoblox_zone_auth" "parent" {
+						fqdn = "dnssec-parent.com"
+						ns_group = "nsgroup1"
+						dnssec_signed = true
+					}
+					resource "infoblox_zone_auth" "child" {
+						fqdn = "child.dnssec-parent.com"
+						view = infoblox_dns_view.view.name
+						ns_group = "nsgroup1"
+						dnssec_signed = false
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_auth.child", "dnssec_signed", "false"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.child", "dnssec_key_params.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.child", "dnssec_ds_records.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceZoneAuthAcls(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneAuthDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "acl_zone" {
+						fqdn = "acl-test.com"
+						allow_transfer {
+							address = "10.0.0.0/24"
+							permission = "ALLOW"
+						}
+						allow_transfer {
+							tsig_key_name = "transfer-key"
+							tsig_key = "X4oRe92t54I+T98NdQpV2w=="
+							tsig_key_alg = "HMAC-MD5"
+						}
+						allow_update {
+							address = "10.0.0.10"
+						}
+						allow_query {
+							address = "Any"
+							permission = "DENY"
+						}
+						update_forwarding {
+							address = "10.1.0.0/16"
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "allow_transfer.#", "2"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "allow_transfer.0.address", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "allow_transfer.1.tsig_key_name", "transfer-key"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "allow_update.0.address", "10.0.0.10"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "allow_update.0.permission", "ALLOW"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "allow_query.0.address", "Any"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "allow_query.0.permission", "DENY"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "update_forwarding.0.address", "10.1.0.0/16"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "acl_zone" {
+						fqdn = "acl-test.com"
+						allow_transfer {
+							address = "10.0.1.0/24"
+							permission = "DENY"
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "allow_transfer.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "allow_transfer.0.address", "10.0.1.0/24"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "allow_transfer.0.permission", "DENY"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "allow_update.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "allow_query.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.acl_zone", "update_forwarding.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceZoneAuthNameServers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneAuthDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "ns_zone" {
+						fqdn = "ns-test.com"
+						grid_primary {
+							name = "infoblox.localdomain"
+						}
+						external_secondaries {
+							name = "ns2.ns-test.com"
+							address = "10.0.0.2"
+							stealth = true
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "ns_group", ""),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "grid_primary.#", "1"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "grid_primary.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "grid_primary.0.stealth", "false"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "external_secondaries.0.name", "ns2.ns-test.com"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "external_secondaries.0.address", "10.0.0.2"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "external_secondaries.0.stealth", "true"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "ns_zone" {
+						fqdn = "ns-test.com"
+						external_primaries {
+							name = "ns1.ns-test.com"
+							address = "10.0.0.1"
+						}
+						grid_secondaries {
+							name = "infoblox.localdomain"
+							lead = true
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "grid_primary.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "external_secondaries.#", "0"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "external_primaries.0.name", "ns1.ns-test.com"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "grid_secondaries.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "grid_secondaries.0.lead", "true"),
+					resource.TestCheckResourceAttr("infoblox_zone_auth.ns_zone", "grid_secondaries.0.grid_replicate", "false"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "ns_zone" {
+						fqdn = "ns-test.com"
+						ns_group = "nsgroup1"
+						grid_primary {
+							name = "infoblox.localdomain"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("'grid_primary' must not be set together with 'ns_group'"),
+			},
+		},
+	})
+}
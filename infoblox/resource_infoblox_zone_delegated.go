@@ -1,8 +1,12 @@
 package infoblox
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
 )
@@ -16,12 +20,19 @@ func resourceZoneDelegated() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceZoneDelegatedImport,
 		},
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return dnsNameFormsCustomizeDiff(d, "fqdn")
+		},
+
 		Schema: map[string]*schema.Schema{
 			"fqdn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The FQDN of the delegated zone.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The FQDN of the delegated zone.",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
+			"fqdn_ascii":   dnsNameAsciiSchema("fqdn"),
+			"fqdn_unicode": dnsNameUnicodeSchema("fqdn"),
 			"delegate_to": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -34,9 +45,10 @@ func resourceZoneDelegated() *schema.Resource {
 							Description: "The IPv4 Address or IPv6 Address of the server.",
 						},
 						"name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "A resolvable domain name for the external DNS server.",
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressDnsNameDiff,
+							Description:      "A resolvable domain name for the external DNS server.",
 						},
 					},
 				},
@@ -88,6 +100,25 @@ func resourceZoneDelegated() *schema.Resource {
 				Default:     "FORWARD",
 				Description: "The format of the zone. Valid values are: FORWARD, IPV4, IPV6.",
 			},
+			"ds_records": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+						if _, err := parseDsRecord(v.(string)); err != nil {
+							return nil, []error{err}
+						}
+						return nil, nil
+					},
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						return normalizeDsRecord(old) == normalizeDsRecord(new)
+					},
+				},
+				Description: "The DS records of the delegation, in the master file format, which are published " +
+					"in the parent zone. The parent zone must be signed. The DS records are left as is if the field is not set.",
+			},
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -109,7 +140,7 @@ func resourceZoneDelegatedCreate(d *schema.ResourceData, m interface{}) error {
 	if intId := d.Get("internal_id"); intId.(string) != "" {
 		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
 	}
-	fqdn := d.Get("fqdn").(string)
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
 
 	nsGroup, nsGroupOk := d.GetOk("ns_group")
 	dtInterface, delegateToOk := d.GetOk("delegate_to")
@@ -176,6 +207,14 @@ func resourceZoneDelegatedCreate(d *schema.ResourceData, m interface{}) error {
 	if err = d.Set("ref", newZoneDelegated.Ref); err != nil {
 		return err
 	}
+
+	if dsRecords, ok := d.GetOk("ds_records"); ok {
+		err = syncZoneDelegatedDsRecords(connector, newZoneDelegated.Ref, fqdn, view, dsRecords.([]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceZoneDelegatedRead(d, m)
 }
 
@@ -286,6 +325,22 @@ func resourceZoneDelegatedRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	view := "default"
+	if zoneDelegated.View != nil {
+		view = *zoneDelegated.View
+	}
+	dsRecords, err := getZoneDelegatedDsRecords(m.(ibclient.IBConnector), zoneDelegated.Fqdn, view)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("ds_records", orderDsRecords(d.Get("ds_records").([]interface{}), dsRecords)); err != nil {
+		return err
+	}
+
+	if err := setDnsNameForms(d, "fqdn"); err != nil {
+		return err
+	}
+
 	d.SetId(zoneDelegated.Ref)
 	return nil
 }
@@ -303,6 +358,7 @@ func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
 			prevDelegateTo, _ := d.GetChange("delegate_to")
 			prevExtAttrs, _ := d.GetChange("ext_attrs")
 			prevTtl, _ := d.GetChange("delegated_ttl")
+			prevDsRecords, _ := d.GetChange("ds_records")
 
 			_ = d.Set("comment", prevComment.(string))
 			_ = d.Set("disable", prevDisable.(bool))
@@ -311,6 +367,7 @@ func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
 			_ = d.Set("delegate_to", prevDelegateTo)
 			_ = d.Set("ext_attrs", prevExtAttrs.(string))
 			_ = d.Set("delegated_ttl", prevTtl.(int))
+			_ = d.Set("ds_records", prevDsRecords)
 		}
 	}()
 
@@ -425,6 +482,18 @@ func resourceZoneDelegatedUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Failed to update zone delegated with %s, ", err.Error())
 	}
 
+	if d.HasChange("ds_records") {
+		view := "default"
+		if zoneDelegated.View != nil {
+			view = *zoneDelegated.View
+		}
+		err = syncZoneDelegatedDsRecords(
+			connector, zoneDelegated.Ref, dnsNameForWapi(d.Get("fqdn").(string)), view, d.Get("ds_records").([]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+
 	updateSuccessful = true
 
 	if err = d.Set("internal_id", newInternalId.String()); err != nil {
@@ -582,3 +651,129 @@ func resourceZoneDelegatedImport(d *schema.ResourceData, m interface{}) ([]*sche
 	}
 	return []*schema.ResourceData{d}, nil
 }
+
+// getZoneDelegatedDsRecords returns the DS records of the delegation, which reside
+// in the parent zone, as a map of the records in the canonical notation to their references.
+func getZoneDelegatedDsRecords(connector ibclient.IBConnector, fqdn string, view string) (map[string]string, error) {
+	ds := &ibclient.RecordDs{}
+	ds.SetReturnFields([]string{"name", "view", "key_tag", "algorithm", "digest_type", "digest"})
+
+	var res []ibclient.RecordDs
+	sf := map[string]string{
+		"name": fqdn,
+		"view": view,
+	}
+	if err := connector.GetObject(ds, "", ibclient.NewQueryParams(false, sf), &res); err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); ok {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("failed to get DS records of the delegation '%s': %w", fqdn, err)
+	}
+
+	records := make(map[string]string, len(res))
+	for _, r := range res {
+		rec := fmt.Sprintf("%s IN DS %d %s %s %s", r.Name, r.KeyTag, r.Algorithm, r.DigestType, r.Digest)
+		records[normalizeDsRecord(rec)] = r.Ref
+	}
+
+	return records, nil
+}
+
+// orderDsRecords returns the DS records read from NIOS in the order and notation of the configured ones,
+// the records which are not configured follow them.
+func orderDsRecords(configured []interface{}, records map[string]string) []interface{} {
+	res := make([]interface{}, 0, len(records))
+	seen := make(map[string]bool, len(records))
+	for _, c := range configured {
+		rec := normalizeDsRecord(c.(string))
+		if _, found := records[rec]; found && !seen[rec] {
+			res = append(res, c)
+			seen[rec] = true
+		}
+	}
+
+	others := make([]string, 0, len(records))
+	for rec := range records {
+		if !seen[rec] {
+			others = append(others, rec)
+		}
+	}
+	sort.Strings(others)
+	for _, rec := range others {
+		res = append(res, rec)
+	}
+
+	return res
+}
+
+// syncZoneDelegatedDsRecords makes the DS records of the delegation in its parent zone match 'dsRecords':
+// the missing records are imported to the parent zone and the extra ones are deleted.
+func syncZoneDelegatedDsRecords(
+	connector ibclient.IBConnector, ref string, fqdn string, view string, dsRecords []interface{}) error {
+
+	current, err := getZoneDelegatedDsRecords(connector, fqdn, view)
+	if err != nil {
+		return err
+	}
+
+	desired := make(map[string]bool, len(dsRecords))
+	var toImport []string
+	for _, r := range dsRecords {
+		rec, err := parseDsRecord(r.(string))
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(rec.owner, strings.TrimSuffix(fqdn, ".")+".") {
+			return fmt.Errorf("the owner of DS record '%s' must be the delegated zone '%s'", r.(string), fqdn)
+		}
+		recStr := rec.String()
+		if desired[recStr] {
+			continue
+		}
+		desired[recStr] = true
+		if _, found := current[recStr]; !found {
+			toImport = append(toImport, recStr)
+		}
+	}
+
+	if len(toImport) > 0 {
+		var zd ibclient.ZoneDelegated
+		zdObj := &ibclient.ZoneDelegated{}
+		zdObj.SetReturnFields([]string{"fqdn", "parent"})
+		if err = connector.GetObject(zdObj, ref, nil, &zd); err != nil {
+			return fmt.Errorf("failed to get the parent zone of the delegation '%s': %w", fqdn, err)
+		}
+
+		var parents []ibclient.ZoneAuth
+		sf := map[string]string{
+			"fqdn": zd.Parent,
+			"view": view,
+		}
+		err = connector.GetObject(&ibclient.ZoneAuth{}, "", ibclient.NewQueryParams(false, sf), &parents)
+		if err != nil {
+			return fmt.Errorf("failed to get the parent zone '%s' of the delegation '%s': %w", zd.Parent, fqdn, err)
+		}
+		if len(parents) == 0 {
+			return fmt.Errorf("the parent zone '%s' of the delegation '%s' is not an authoritative zone", zd.Parent, fqdn)
+		}
+
+		args := map[string]string{
+			"operation": dsImportOperation,
+			"buffer":    strings.Join(toImport, "\n"),
+		}
+		if err = callWapiFunction(connector, parents[0].Ref, "dnssec_operation", args, nil); err != nil {
+			return fmt.Errorf("failed to import DS records of the delegation '%s': %w", fqdn, err)
+		}
+	}
+
+	for rec, dsRef := range current {
+		if desired[rec] {
+			continue
+		}
+		if _, err = connector.DeleteObject(dsRef); err != nil {
+			return fmt.Errorf("failed to delete DS record '%s': %w", rec, err)
+		}
+	}
+
+	return nil
+}
@@ -24,15 +24,21 @@ func resourceZoneForward() *schema.Resource {
 					return err
 				}
 			}
+			if err := dnsNameFormsCustomizeDiff(d, "fqdn"); err != nil {
+				return err
+			}
 			return nil
 		},
 
 		Schema: map[string]*schema.Schema{
 			"fqdn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this DNS zone",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The name of this DNS zone",
+				DiffSuppressFunc: suppressDnsNameDiff,
 			},
+			"fqdn_ascii":   dnsNameAsciiSchema("fqdn"),
+			"fqdn_unicode": dnsNameUnicodeSchema("fqdn"),
 			"forward_to": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -45,9 +51,10 @@ func resourceZoneForward() *schema.Resource {
 							Description: "The IP address of the remote name server to which you want the Infoblox appliance to forward queries for a specified domain name.",
 						},
 						"name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The name of the remote name server to which you want the Infoblox appliance to forward queries for a specified domain name.",
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressDnsNameDiff,
+							Description:      "The name of the remote name server to which you want the Infoblox appliance to forward queries for a specified domain name.",
 						},
 					},
 				},
@@ -131,9 +138,10 @@ func resourceZoneForward() *schema.Resource {
 										Description: "The IP address of the remote name server to which you want the Infoblox appliance to forward queries for a specified domain name.",
 									},
 									"name": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The name of the remote name server to which you want the Infoblox appliance to forward queries for a specified domain name.",
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressDnsNameDiff,
+										Description:      "The name of the remote name server to which you want the Infoblox appliance to forward queries for a specified domain name.",
 									},
 								},
 							},
@@ -187,7 +195,7 @@ func resourceZoneForwardCreate(d *schema.ResourceData, m interface{}) error {
 		nullFWT = ibclient.NullableNameServers{IsNull: false, NameServers: forwardTo}
 	}
 
-	fqdn := d.Get("fqdn").(string)
+	fqdn := dnsNameForWapi(d.Get("fqdn").(string))
 	nsGroup := d.Get("ns_group").(string)
 	externalNsGroup := d.Get("external_ns_group").(string)
 	view := d.Get("view").(string)
@@ -255,6 +263,14 @@ func validateForwardingServers(fsSlice []interface{}) ([]*ibclient.Forwardingmem
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal forwarding_servers: %s", err)
 	}
+	for _, fs := range forwardingServer {
+		if fs == nil {
+			continue
+		}
+		for i := range fs.ForwardTo.NameServers {
+			fs.ForwardTo.NameServers[i].Name = dnsNameForWapi(fs.ForwardTo.NameServers[i].Name)
+		}
+	}
 	return forwardingServer, nil
 }
 
@@ -268,6 +284,9 @@ func validateNameServers(ftSlice []interface{}) ([]ibclient.NameServer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal nameservers: %s", err)
 	}
+	for i := range nameServers {
+		nameServers[i].Name = dnsNameForWapi(nameServers[i].Name)
+	}
 	return nameServers, nil
 }
 
@@ -379,6 +398,10 @@ func resourceZoneForwardRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	if err := setDnsNameForms(d, "fqdn"); err != nil {
+		return err
+	}
+
 	d.SetId(zoneForward.Ref)
 	return nil
 }
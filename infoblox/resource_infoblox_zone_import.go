@@ -0,0 +1,436 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// zoneImportValidationOrigin is the zone the content is parsed for at validation time,
+// when the actual zone may not be known yet.
+const zoneImportValidationOrigin = "zone-import.invalid"
+
+func resourceZoneImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZoneImportCreate,
+		ReadContext:   resourceZoneImportRead,
+		UpdateContext: resourceZoneImportUpdate,
+		DeleteContext: resourceZoneImportDelete,
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				if err := d.SetNewComputed("internal_id"); err != nil {
+					return err
+				}
+			}
+
+			// The records which were deleted or changed outside of Terraform are re-created.
+			if d.Id() == "" || d.HasChanges("zone", "view", "content") {
+				return nil
+			}
+			records, _, err := parseZoneFile(d.Get("content").(string), dnsNameForWapi(d.Get("zone").(string)), d.Get("view").(string))
+			if err != nil {
+				return err
+			}
+			existing := make(map[string]bool)
+			for _, r := range d.Get("records").([]interface{}) {
+				existing[zoneImportRecordKey(r.(map[string]interface{}))] = true
+			}
+			for _, rec := range records {
+				if !existing[rec.key()] {
+					return d.SetNewComputed("records")
+				}
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressDnsNameDiff,
+				Description:      "The FQDN of the authoritative zone the records are created in.",
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "The name of the DNS view in which the zone resides.",
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The content of the zone in the RFC 1035 master file format; " +
+					"relative names are relative to the zone.",
+				ValidateDiagFunc: validateZoneFileContent,
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the created records, as a map in JSON format.",
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The records created out of the content.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The absolute name of the record.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the record.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The TTL of the record, -1 if the record uses the zone's default TTL.",
+						},
+						"rdata": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The data of the record in the master file format.",
+						},
+						"ref": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "NIOS object's reference of the record.",
+						},
+						"internal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: "Internal ID of the record at NIOS side, the record can be imported " +
+								"into the resource of its type by it.",
+						},
+					},
+				},
+			},
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of the import, used by Infoblox Terraform plugin " +
+					"to identify the set of the created records.",
+			},
+		},
+	}
+}
+
+// validateZoneFileContent reports the syntax errors of the content as errors
+// and the records which are not going to be created as warnings.
+func validateZoneFileContent(v interface{}, path cty.Path) diag.Diagnostics {
+	_, skipped, err := parseZoneFile(v.(string), zoneImportValidationOrigin, defaultDNSView)
+	if err != nil {
+		// Names out of the zone cannot be detected until the zone is known.
+		var outOfZone *zoneFileOutOfZoneError
+		if errors.As(err, &outOfZone) {
+			return nil
+		}
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "invalid zone file content",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+
+	return zoneImportSkippedDiags(skipped, path)
+}
+
+func zoneImportSkippedDiags(skipped []string, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, s := range skipped {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "unsupported record in zone file content",
+			Detail:        s,
+			AttributePath: path,
+		})
+	}
+	return diags
+}
+
+// zoneImportRecordKey identifies a record of the resource's state by its content.
+func zoneImportRecordKey(r map[string]interface{}) string {
+	ttl := r["ttl"].(int)
+	return zoneRecordKey(r["fqdn"].(string), r["type"].(string), ttl >= 0, uint32(ttl), r["rdata"].(string))
+}
+
+// parseZoneImportContent parses the content of the resource,
+// the zone must exist: the records are created in it.
+func parseZoneImportContent(d *schema.ResourceData, m interface{}) ([]*parsedZoneRecord, diag.Diagnostics) {
+	zone := dnsNameForWapi(d.Get("zone").(string))
+	view := d.Get("view").(string)
+
+	connector := m.(ibclient.IBConnector)
+	var zones []ibclient.ZoneAuth
+	sf := map[string]string{
+		"fqdn": zone,
+		"view": view,
+	}
+	err := connector.GetObject(&ibclient.ZoneAuth{}, "", ibclient.NewQueryParams(false, sf), &zones)
+	if err != nil || len(zones) == 0 {
+		return nil, diag.Errorf("authoritative zone '%s' not found in DNS view '%s'", zone, view)
+	}
+
+	records, skipped, err := parseZoneFile(d.Get("content").(string), zone, view)
+	if err != nil {
+		return nil, diag.Errorf("invalid zone file content: %s", err)
+	}
+
+	return records, zoneImportSkippedDiags(skipped, cty.GetAttrPath("content"))
+}
+
+// newZoneImportRecordState returns the state of a created record.
+func newZoneImportRecordState(rec *parsedZoneRecord, ref string, internalId string) map[string]interface{} {
+	ttl := -1
+	if rec.useTtl {
+		ttl = int(rec.ttl)
+	}
+
+	return map[string]interface{}{
+		"fqdn":        rec.fqdn,
+		"type":        rec.rrType,
+		"ttl":         ttl,
+		"rdata":       rec.rdata,
+		"ref":         ref,
+		"internal_id": internalId,
+	}
+}
+
+// applyZoneImport creates the records of 'create' and deletes the records of 'remove'
+// in one transaction, the states of the created records are returned.
+func applyZoneImport(
+	d *schema.ResourceData, m interface{}, create []*parsedZoneRecord, remove []map[string]interface{}) ([]map[string]interface{}, error) {
+
+	extAttrs, err := terraformDeserializeEAs(d.Get("ext_attrs").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]wapiRequestItem, 0, len(create)+len(remove))
+	for _, r := range remove {
+		items = append(items, wapiRequestItem{
+			Method: "DELETE",
+			Object: r["ref"].(string),
+		})
+	}
+
+	internalIds := make([]string, 0, len(create))
+	for _, rec := range create {
+		internalId := generateInternalId().String()
+		internalIds = append(internalIds, internalId)
+
+		eas := make(map[string]interface{}, len(extAttrs)+1)
+		for k, v := range extAttrs {
+			eas[k] = map[string]interface{}{"value": v}
+		}
+		eas[eaNameForInternalId] = map[string]interface{}{"value": internalId}
+
+		data := make(map[string]interface{}, len(rec.data)+1)
+		for k, v := range rec.data {
+			data[k] = v
+		}
+		data["extattrs"] = eas
+
+		items = append(items, wapiRequestItem{
+			Method: "POST",
+			Object: rec.objType,
+			Data:   data,
+		})
+	}
+
+	results, err := multiRequest(m.(ibclient.IBConnector), items)
+	if err != nil {
+		return nil, err
+	}
+
+	created := make([]map[string]interface{}, 0, len(create))
+	for i, rec := range create {
+		var ref string
+		if err = json.Unmarshal(results[len(remove)+i], &ref); err != nil {
+			return nil, fmt.Errorf("unexpected result of creating %s record '%s': %s",
+				rec.rrType, rec.fqdn, string(results[len(remove)+i]))
+		}
+		created = append(created, newZoneImportRecordState(rec, ref, internalIds[i]))
+	}
+
+	return created, nil
+}
+
+func setZoneImportRecords(d *schema.ResourceData, records []map[string]interface{}) error {
+	list := make([]interface{}, 0, len(records))
+	for _, r := range records {
+		list = append(list, r)
+	}
+	return d.Set("records", list)
+}
+
+func resourceZoneImportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return diag.FromErr(fmt.Errorf("the value of 'internal_id' field must not be set manually"))
+	}
+
+	records, diags := parseZoneImportContent(d, m)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The records which are present in the content more than once are created once.
+	unique := make([]*parsedZoneRecord, 0, len(records))
+	seen := make(map[string]bool, len(records))
+	for _, rec := range records {
+		if !seen[rec.key()] {
+			seen[rec.key()] = true
+			unique = append(unique, rec)
+		}
+	}
+
+	created, err := applyZoneImport(d, m, unique, nil)
+	if err != nil {
+		return append(diags, diag.Errorf("failed to import zone file content: %s", err)...)
+	}
+
+	internalId := generateInternalId()
+	d.SetId(internalId.String())
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err = setZoneImportRecords(d, created); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceZoneImportRead(ctx, d, m)...)
+}
+
+func resourceZoneImportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := m.(ibclient.IBConnector)
+
+	existing, err := getZoneRecords(connector, dnsNameForWapi(d.Get("zone").(string)), d.Get("view").(string), nil)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); ok {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	refs := make(map[string]bool, len(existing))
+	for _, rec := range existing {
+		refs[rec.Record] = true
+	}
+
+	// The records which do not exist anymore are removed from the state,
+	// they are re-created by the next update.
+	var records []map[string]interface{}
+	for _, r := range d.Get("records").([]interface{}) {
+		rec := r.(map[string]interface{})
+		if refs[rec["ref"].(string)] {
+			records = append(records, rec)
+		}
+	}
+
+	if err = setZoneImportRecords(d, records); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceZoneImportUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			for _, field := range []string{"zone", "view", "content", "ext_attrs"} {
+				prevVal, _ := d.GetChange(field)
+				_ = d.Set(field, prevVal)
+			}
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return diag.FromErr(fmt.Errorf("changing the value of 'internal_id' field is not allowed"))
+	}
+
+	records, diags := parseZoneImportContent(d, m)
+	if diags.HasError() {
+		return diags
+	}
+
+	oldRecords, _ := d.GetChange("records")
+	current := make(map[string]map[string]interface{})
+	for _, r := range oldRecords.([]interface{}) {
+		rec := r.(map[string]interface{})
+		current[zoneImportRecordKey(rec)] = rec
+	}
+
+	// All the records are re-created if the zone, the view or the extensible attributes change,
+	// otherwise only the records which are added or removed by the change of the content.
+	recreate := d.HasChanges("zone", "view", "ext_attrs")
+
+	var (
+		kept   []map[string]interface{}
+		create []*parsedZoneRecord
+		remove []map[string]interface{}
+	)
+	desired := make(map[string]bool, len(records))
+	for _, rec := range records {
+		key := rec.key()
+		if desired[key] {
+			continue
+		}
+		desired[key] = true
+		if state, found := current[key]; found && !recreate {
+			kept = append(kept, state)
+		} else {
+			create = append(create, rec)
+		}
+	}
+	for key, state := range current {
+		if !desired[key] || recreate {
+			remove = append(remove, state)
+		}
+	}
+
+	created, err := applyZoneImport(d, m, create, remove)
+	if err != nil {
+		return append(diags, diag.Errorf("failed to update the records of zone file content: %s", err)...)
+	}
+	updateSuccessful = true
+
+	if err = setZoneImportRecords(d, append(kept, created...)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceZoneImportRead(ctx, d, m)...)
+}
+
+func resourceZoneImportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Only the records which still exist are deleted, otherwise the whole request would fail.
+	if diags := resourceZoneImportRead(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	var remove []map[string]interface{}
+	for _, r := range d.Get("records").([]interface{}) {
+		remove = append(remove, r.(map[string]interface{}))
+	}
+
+	if _, err := applyZoneImport(d, m, nil, remove); err != nil {
+		return diag.Errorf("failed to delete the records of zone file content: %s", err)
+	}
+	d.SetId("")
+
+	return nil
+}
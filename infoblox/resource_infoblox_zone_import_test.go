@@ -0,0 +1,141 @@
+package infoblox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+func testAccCheckZoneImportDestroy(s *terraform.State) error {
+	connector := testAccProvider.Meta().(ibclient.IBConnector)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_import" {
+			continue
+		}
+		for key, ref := range rs.Primary.Attributes {
+			if !regexp.MustCompile(`^records\.\d+\.ref$`).MatchString(key) {
+				continue
+			}
+			var rec map[string]interface{}
+			err := connector.GetObject(newWapiObject("allrecords", nil), ref, ibclient.NewQueryParams(false, nil), &rec)
+			if err == nil && rec != nil {
+				return fmt.Errorf("record '%s' still exists", ref)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccZoneImportRecordExists(resPath string, fqdn string, rrType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		for i := 0; ; i++ {
+			attrType, found := res.Primary.Attributes[fmt.Sprintf("records.%d.type", i)]
+			if !found {
+				return fmt.Errorf("%s record '%s' is not in the state", rrType, fqdn)
+			}
+			if attrType != rrType || res.Primary.Attributes[fmt.Sprintf("records.%d.fqdn", i)] != fqdn {
+				continue
+			}
+
+			ref := res.Primary.Attributes[fmt.Sprintf("records.%d.ref", i)]
+			var rec map[string]interface{}
+			obj := newWapiObject(zoneRecordTypes[rrType].objType, []string{"extattrs"})
+			if err := connector.GetObject(obj, ref, ibclient.NewQueryParams(false, nil), &rec); err != nil {
+				return fmt.Errorf("failed to get %s record '%s': %w", rrType, fqdn, err)
+			}
+			if getInternalIdFromWapiObject(rec) != res.Primary.Attributes[fmt.Sprintf("records.%d.internal_id", i)] {
+				return fmt.Errorf("%s record '%s' is not tagged with its internal ID", rrType, fqdn)
+			}
+			return nil
+		}
+	}
+}
+
+func TestAccResourceZoneImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "zoneimport-test.com"
+					}
+
+					resource "infoblox_zone_import" "legacy" {
+						zone = infoblox_zone_auth.zone.fqdn
+						content = <<-EOT
+							$TTL 3600
+							@	IN	SOA	ns1 hostmaster ( 1 3600 900 604800 300 )
+							@	IN	MX	10 mail
+							www	300	IN	A	10.0.0.10
+								IN	AAAA	2001:db8::10
+							mail	IN	A	10.0.0.20
+							ftp	IN	CNAME	www
+							txt	IN	TXT	"v=spf1 -all"
+						EOT
+						ext_attrs = jsonencode({
+							"Location" = "Test loc."
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_import.legacy", "records.#", "6"),
+					testAccZoneImportRecordExists("infoblox_zone_import.legacy", "www.zoneimport-test.com.", "A"),
+					testAccZoneImportRecordExists("infoblox_zone_import.legacy", "www.zoneimport-test.com.", "AAAA"),
+					testAccZoneImportRecordExists("infoblox_zone_import.legacy", "zoneimport-test.com.", "MX"),
+					testAccZoneImportRecordExists("infoblox_zone_import.legacy", "ftp.zoneimport-test.com.", "CNAME"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "zoneimport-test.com"
+					}
+
+					resource "infoblox_zone_import" "legacy" {
+						zone = infoblox_zone_auth.zone.fqdn
+						content = <<-EOT
+							$TTL 3600
+							@	IN	MX	10 mail
+							www	300	IN	A	10.0.0.10
+							mail	IN	A	10.0.0.21
+							txt	IN	TXT	"v=spf1 -all"
+						EOT
+						ext_attrs = jsonencode({
+							"Location" = "Test loc."
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_zone_import.legacy", "records.#", "4"),
+					testAccZoneImportRecordExists("infoblox_zone_import.legacy", "mail.zoneimport-test.com.", "A"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_auth" "zone" {
+						fqdn = "zoneimport-test.com"
+					}
+
+					resource "infoblox_zone_import" "legacy" {
+						zone = infoblox_zone_auth.zone.fqdn
+						content = <<-EOT
+							$INCLUDE other.zone
+						EOT
+					}`,
+				ExpectError: regexp.MustCompile("directive '\\$INCLUDE' is not supported"),
+			},
+		},
+	})
+}
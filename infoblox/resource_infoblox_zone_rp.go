@@ -0,0 +1,592 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+var zoneRpReturnFields = []string{
+	"fqdn",
+	"view",
+	"rpz_type",
+	"rpz_policy",
+	"rpz_severity",
+	"rpz_priority",
+	"substitute_name",
+	"log_rpz",
+	"use_log_rpz",
+	"ns_group",
+	"grid_primary",
+	"grid_secondaries",
+	"external_primaries",
+	"use_external_primary",
+	"comment",
+	"disable",
+	"locked",
+	"extattrs",
+}
+
+func newEmptyZoneRp() *ibclient.ZoneRp {
+	zone := &ibclient.ZoneRp{}
+	zone.SetReturnFields(zoneRpReturnFields)
+
+	return zone
+}
+
+// zoneRp extends ibclient.ZoneRp with the name server group, the substitute name and the lists of name servers,
+// which the client's struct cannot clear: an empty group is sent as null, the other empty values as they are.
+type zoneRp struct {
+	ibclient.ZoneRp
+	NsGroup           *string                   `json:"ns_group"`
+	SubstituteName    *string                   `json:"substitute_name,omitempty"`
+	GridPrimary       *[]*ibclient.Memberserver `json:"grid_primary,omitempty"`
+	GridSecondaries   *[]*ibclient.Memberserver `json:"grid_secondaries,omitempty"`
+	ExternalPrimaries *[]ibclient.NameServer    `json:"external_primaries,omitempty"`
+}
+
+// memberServerSchema describes a Grid member which serves a zone.
+var memberServerSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the Grid member in FQDN format.",
+		},
+		"stealth": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Determines if NS and glue records for the member are hidden.",
+		},
+	},
+}
+
+func resourceZoneRp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceZoneRpCreate,
+		Read:   resourceZoneRpRead,
+		Update: resourceZoneRpUpdate,
+		Delete: resourceZoneRpDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceZoneRpImport,
+		},
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			if err := dnsNameFormsCustomizeDiff(d, "fqdn"); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The name of the response policy zone.",
+				DiffSuppressFunc: suppressDnsNameDiff,
+			},
+			"fqdn_ascii":   dnsNameAsciiSchema("fqdn"),
+			"fqdn_unicode": dnsNameUnicodeSchema("fqdn"),
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "The DNS view in which the zone is created.",
+			},
+			"rpz_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "LOCAL",
+				ValidateFunc: validation.StringInSlice([]string{"LOCAL", "FEED"}, false),
+				Description: "The type of the response policy zone: LOCAL for locally maintained rules" +
+					" or FEED for the zone which is transferred from an RPZ feed (external primaries).",
+			},
+			"rpz_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "GIVEN",
+				ValidateFunc: validation.StringInSlice([]string{
+					"GIVEN", "DISABLED", "PASSTHRU", "NXDOMAIN", "NODATA", "SUBSTITUTE"}, false),
+				Description: "The override policy of the zone; GIVEN means the rules' own actions are applied.",
+			},
+			"substitute_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				DiffSuppressFunc: suppressDnsNameDiff,
+				Description:      "The canonical name of the redirect target; required if 'rpz_policy' is SUBSTITUTE.",
+			},
+			"rpz_severity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "MAJOR",
+				ValidateFunc: validation.StringInSlice([]string{
+					"CRITICAL", "MAJOR", "WARNING", "INFORMATIONAL"}, false),
+				Description: "The severity of the zone's rule hits, as reported in logs and security reports.",
+			},
+			"rpz_priority": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The priority of the zone among the response policy zones of the view.",
+			},
+			"log_rpz": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Determines whether RPZ rule hits are logged.",
+			},
+			"ns_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name server group which serves the zone.",
+			},
+			"grid_primary": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The Grid primary servers of the zone.",
+				Elem:        memberServerSchema,
+			},
+			"grid_secondaries": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The Grid secondary servers of the zone; for a FEED zone these are the members receiving the feed.",
+				Elem:        memberServerSchema,
+			},
+			"external_primaries": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The external primary servers of the zone; for a FEED zone these are the RPZ feed servers.",
+				Elem:        externalServerSchema,
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if the zone is disabled or not.",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, other administrators cannot make conflicting changes.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A descriptive comment.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the response policy zone to be added/updated, as a map in JSON format.",
+			},
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func convertMemberServersFromInterface(members []interface{}) []*ibclient.Memberserver {
+	res := make([]*ibclient.Memberserver, 0, len(members))
+	for _, member := range members {
+		memberMap := member.(map[string]interface{})
+		ms := &ibclient.Memberserver{
+			Name: memberMap["name"].(string),
+		}
+		if stealth, ok := memberMap["stealth"]; ok {
+			ms.Stealth = stealth.(bool)
+		}
+		if lead, ok := memberMap["lead"]; ok {
+			ms.Lead = lead.(bool)
+		}
+		if gridReplicate, ok := memberMap["grid_replicate"]; ok {
+			ms.GridReplicate = gridReplicate.(bool)
+		}
+		res = append(res, ms)
+	}
+
+	return res
+}
+
+func convertMemberServersToInterface(members []*ibclient.Memberserver) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(members))
+	for _, member := range members {
+		if member == nil {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"name":    member.Name,
+			"stealth": member.Stealth,
+		})
+	}
+
+	return res
+}
+
+// formZoneRp builds a WAPI object out of the resource's data,
+// with the set of fields which are allowed to be sent on an update operation.
+func formZoneRp(d *schema.ResourceData) (*zoneRp, error) {
+	rpzPolicy := d.Get("rpz_policy").(string)
+	substituteName := dnsNameForWapi(d.Get("substitute_name").(string))
+	if rpzPolicy == "SUBSTITUTE" && substituteName == "" {
+		return nil, fmt.Errorf("'substitute_name' must be set when 'rpz_policy' is SUBSTITUTE")
+	}
+	if rpzPolicy != "SUBSTITUTE" && substituteName != "" {
+		return nil, fmt.Errorf("'substitute_name' may be set only when 'rpz_policy' is SUBSTITUTE")
+	}
+
+	gridPrimary := convertMemberServersFromInterface(d.Get("grid_primary").([]interface{}))
+	gridSecondaries := convertMemberServersFromInterface(d.Get("grid_secondaries").([]interface{}))
+	externalPrimaries, err := validateNameServers(d.Get("external_primaries").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	if externalPrimaries == nil {
+		externalPrimaries = []ibclient.NameServer{}
+	}
+
+	zone := &zoneRp{
+		ZoneRp: ibclient.ZoneRp{
+			RpzPolicy:          rpzPolicy,
+			RpzSeverity:        d.Get("rpz_severity").(string),
+			LogRpz:             utils.BoolPtr(d.Get("log_rpz").(bool)),
+			UseLogRpz:          utils.BoolPtr(true),
+			UseExternalPrimary: utils.BoolPtr(len(externalPrimaries) > 0),
+			Comment:            utils.StringPtr(d.Get("comment").(string)),
+			Disable:            utils.BoolPtr(d.Get("disable").(bool)),
+			Locked:             utils.BoolPtr(d.Get("locked").(bool)),
+		},
+		SubstituteName:    &substituteName,
+		GridPrimary:       &gridPrimary,
+		GridSecondaries:   &gridSecondaries,
+		ExternalPrimaries: &externalPrimaries,
+	}
+	if nsGroup := d.Get("ns_group").(string); nsGroup != "" {
+		zone.NsGroup = &nsGroup
+	}
+
+	return zone, nil
+}
+
+func setZoneRpFields(d *schema.ResourceData, zone *ibclient.ZoneRp) error {
+	if err := d.Set("fqdn", zone.Fqdn); err != nil {
+		return err
+	}
+
+	if zone.View != nil {
+		if err := d.Set("view", *zone.View); err != nil {
+			return err
+		}
+	}
+
+	for field, val := range map[string]string{
+		"rpz_type":     zone.RpzType,
+		"rpz_policy":   zone.RpzPolicy,
+		"rpz_severity": zone.RpzSeverity,
+	} {
+		if err := d.Set(field, val); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("rpz_priority", int(zone.RpzPriority)); err != nil {
+		return err
+	}
+
+	substituteName := ""
+	if zone.SubstituteName != nil {
+		substituteName = *zone.SubstituteName
+	}
+	if err := d.Set("substitute_name", substituteName); err != nil {
+		return err
+	}
+
+	if zone.LogRpz != nil {
+		if err := d.Set("log_rpz", *zone.LogRpz); err != nil {
+			return err
+		}
+	}
+
+	nsGroup := ""
+	if zone.NsGroup != nil {
+		nsGroup = *zone.NsGroup
+	}
+	if err := d.Set("ns_group", nsGroup); err != nil {
+		return err
+	}
+
+	if err := d.Set("grid_primary", convertMemberServersToInterface(zone.GridPrimary)); err != nil {
+		return err
+	}
+	if err := d.Set("grid_secondaries", convertMemberServersToInterface(zone.GridSecondaries)); err != nil {
+		return err
+	}
+	if err := d.Set("external_primaries", convertExternalServersToInterface(zone.ExternalPrimaries)); err != nil {
+		return err
+	}
+
+	comment := ""
+	if zone.Comment != nil {
+		comment = *zone.Comment
+	}
+	if err := d.Set("comment", comment); err != nil {
+		return err
+	}
+
+	if err := d.Set("disable", zone.Disable != nil && *zone.Disable); err != nil {
+		return err
+	}
+	if err := d.Set("locked", zone.Locked != nil && *zone.Locked); err != nil {
+		return err
+	}
+
+	return d.Set("ref", zone.Ref)
+}
+
+func resourceZoneRpCreate(d *schema.ResourceData, m interface{}) error {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+	}
+
+	zone, err := formZoneRp(d)
+	if err != nil {
+		return err
+	}
+	zone.Fqdn = dnsNameForWapi(d.Get("fqdn").(string))
+	zone.View = utils.StringPtr(d.Get("view").(string))
+	zone.RpzType = d.Get("rpz_type").(string)
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	zone.Ea = extAttrs
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(zone)
+	if err != nil {
+		return fmt.Errorf("failed to create response policy zone: %s", err)
+	}
+
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return err
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+
+	return resourceZoneRpRead(d, m)
+}
+
+func resourceZoneRpRead(d *schema.ResourceData, m interface{}) error {
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	var zone ibclient.ZoneRp
+	err = searchGenericObjectByRefOrInternalId(newEmptyZoneRp(), d, m, &zone)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if err = setZoneRpFields(d, &zone); err != nil {
+		return err
+	}
+
+	delete(zone.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(zone.Ea, extAttrs)
+
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return err
+		}
+	}
+
+	if err := setDnsNameForms(d, "fqdn"); err != nil {
+		return err
+	}
+
+	d.SetId(zone.Ref)
+
+	return nil
+}
+
+func resourceZoneRpUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			for _, field := range []string{
+				"rpz_policy", "substitute_name", "rpz_severity", "log_rpz", "ns_group",
+				"grid_primary", "grid_secondaries", "external_primaries",
+				"disable", "locked", "comment", "ext_attrs",
+			} {
+				prevVal, _ := d.GetChange(field)
+				_ = d.Set(field, prevVal)
+			}
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+	}
+	if d.HasChange("fqdn") {
+		return fmt.Errorf("changing the value of 'fqdn' field is not allowed")
+	}
+	if d.HasChange("view") {
+		return fmt.Errorf("changing the value of 'view' field is not allowed")
+	}
+	if d.HasChange("rpz_type") {
+		return fmt.Errorf("changing the value of 'rpz_type' field is not allowed")
+	}
+
+	zone, err := formZoneRp(d)
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
+
+	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	var currentZone ibclient.ZoneRp
+	err = searchGenericObjectByRefOrInternalId(newEmptyZoneRp(), d, m, &currentZone)
+	if err != nil {
+		return fmt.Errorf("failed to read response policy zone for update operation: %w", err)
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	zone.Ea, err = mergeEAs(currentZone.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return err
+	}
+
+	ref, err := connector.UpdateObject(zone, currentZone.Ref)
+	if err != nil {
+		return fmt.Errorf("failed to update response policy zone: %s", err)
+	}
+	updateSuccessful = true
+
+	d.SetId(ref)
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return err
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+
+	return resourceZoneRpRead(d, m)
+}
+
+func resourceZoneRpDelete(d *schema.ResourceData, m interface{}) error {
+	var zone ibclient.ZoneRp
+	err := searchGenericObjectByRefOrInternalId(newEmptyZoneRp(), d, m, &zone)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	connector := m.(ibclient.IBConnector)
+	if _, err = connector.DeleteObject(zone.Ref); err != nil {
+		return fmt.Errorf("failed to delete response policy zone: %s", err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceZoneRpImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(ibclient.IBConnector)
+
+	var zone ibclient.ZoneRp
+	if err := connector.GetObject(newEmptyZoneRp(), d.Id(), ibclient.NewQueryParams(false, nil), &zone); err != nil {
+		return nil, fmt.Errorf("failed getting response policy zone: %w", err)
+	}
+
+	if err := setZoneRpFields(d, &zone); err != nil {
+		return nil, err
+	}
+
+	if zone.Ea != nil && len(zone.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(zone.Ea)
+		if err != nil {
+			return nil, err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(zone.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if err := resourceZoneRpUpdate(d, m); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
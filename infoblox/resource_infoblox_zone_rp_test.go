@@ -0,0 +1,160 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+func testAccCheckZoneRpDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_rp" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var zone ibclient.ZoneRp
+		err := connector.GetObject(newEmptyZoneRp(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &zone)
+		if err == nil && zone.Ref != "" {
+			return fmt.Errorf("response policy zone still exists")
+		}
+	}
+	return nil
+}
+
+func testAccZoneRpCompare(t *testing.T, resPath string, expectedZone *ibclient.ZoneRp) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.Attributes["internal_id"] == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		var zone ibclient.ZoneRp
+		err := connector.GetObject(newEmptyZoneRp(), res.Primary.Attributes["ref"], ibclient.NewQueryParams(false, nil), &zone)
+		if err != nil {
+			return err
+		}
+
+		if zone.Fqdn != expectedZone.Fqdn {
+			return fmt.Errorf("'fqdn' does not match: got '%s', expected '%s'", zone.Fqdn, expectedZone.Fqdn)
+		}
+		if *zone.View != *expectedZone.View {
+			return fmt.Errorf("'view' does not match: got '%s', expected '%s'", *zone.View, *expectedZone.View)
+		}
+		if zone.RpzPolicy != expectedZone.RpzPolicy {
+			return fmt.Errorf("'rpz_policy' does not match: got '%s', expected '%s'", zone.RpzPolicy, expectedZone.RpzPolicy)
+		}
+		if zone.RpzSeverity != expectedZone.RpzSeverity {
+			return fmt.Errorf("'rpz_severity' does not match: got '%s', expected '%s'", zone.RpzSeverity, expectedZone.RpzSeverity)
+		}
+		if expectedZone.SubstituteName != nil {
+			substituteName := ""
+			if zone.SubstituteName != nil {
+				substituteName = *zone.SubstituteName
+			}
+			if substituteName != *expectedZone.SubstituteName {
+				return fmt.Errorf(
+					"'substitute_name' does not match: got '%s', expected '%s'", substituteName, *expectedZone.SubstituteName)
+			}
+		}
+		if expectedZone.GridPrimary != nil {
+			if len(zone.GridPrimary) != len(expectedZone.GridPrimary) {
+				return fmt.Errorf(
+					"the number of 'grid_primary' members does not match: got '%d', expected '%d'",
+					len(zone.GridPrimary), len(expectedZone.GridPrimary))
+			}
+			for i, member := range zone.GridPrimary {
+				if member.Name != expectedZone.GridPrimary[i].Name {
+					return fmt.Errorf(
+						"'grid_primary' member #%d does not match: got '%s', expected '%s'",
+						i, member.Name, expectedZone.GridPrimary[i].Name)
+				}
+			}
+		}
+
+		return validateEAs(zone.Ea, expectedZone.Ea)
+	}
+}
+
+func TestAccResourceZoneRp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneRpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_rp" "rpz" {
+						fqdn = "rpz.test.com"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneRpCompare(t, "infoblox_zone_rp.rpz", &ibclient.ZoneRp{
+						Fqdn:        "rpz.test.com",
+						View:        utils.StringPtr("default"),
+						RpzPolicy:   "GIVEN",
+						RpzSeverity: "MAJOR",
+					}),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz", "rpz_type", "LOCAL"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_rp" "rpz" {
+						fqdn = "rpz.test.com"
+						rpz_policy = "SUBSTITUTE"
+						substitute_name = "walled-garden.test.com"
+						rpz_severity = "CRITICAL"
+						grid_primary {
+							name = "infoblox.localdomain"
+						}
+						comment = "security policy"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneRpCompare(t, "infoblox_zone_rp.rpz", &ibclient.ZoneRp{
+						Fqdn:           "rpz.test.com",
+						View:           utils.StringPtr("default"),
+						RpzPolicy:      "SUBSTITUTE",
+						SubstituteName: utils.StringPtr("walled-garden.test.com"),
+						RpzSeverity:    "CRITICAL",
+						GridPrimary: []*ibclient.Memberserver{
+							{Name: "infoblox.localdomain"},
+						},
+						Ea: ibclient.EA{"Site": "HQ"},
+					}),
+				),
+			},
+			// Leaving the SUBSTITUTE policy and removing the Grid primary clear them on the zone.
+			{
+				Config: `
+					resource "infoblox_zone_rp" "rpz" {
+						fqdn = "rpz.test.com"
+						rpz_severity = "CRITICAL"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneRpCompare(t, "infoblox_zone_rp.rpz", &ibclient.ZoneRp{
+						Fqdn:           "rpz.test.com",
+						View:           utils.StringPtr("default"),
+						RpzPolicy:      "GIVEN",
+						SubstituteName: utils.StringPtr(""),
+						RpzSeverity:    "CRITICAL",
+						GridPrimary:    []*ibclient.Memberserver{},
+					}),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz", "substitute_name", ""),
+					resource.TestCheckResourceAttr("infoblox_zone_rp.rpz", "grid_primary.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,643 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+var zoneStubReturnFields = []string{
+	"fqdn",
+	"view",
+	"zone_format",
+	"prefix",
+	"comment",
+	"disable",
+	"disable_forwarding",
+	"locked",
+	"ns_group",
+	"external_ns_group",
+	"stub_from",
+	"stub_members",
+	"stub_msservers",
+	"ms_ad_integrated",
+	"extattrs",
+}
+
+func newEmptyZoneStub() *ibclient.ZoneStub {
+	zone := &ibclient.ZoneStub{}
+	zone.SetReturnFields(zoneStubReturnFields)
+
+	return zone
+}
+
+// zoneStub extends ibclient.ZoneStub with the name server groups and the lists of stub members,
+// which the client's struct cannot clear: empty groups are sent as nulls and empty lists as empty arrays.
+type zoneStub struct {
+	ibclient.ZoneStub
+	NsGroup         *string                   `json:"ns_group"`
+	ExternalNsGroup *string                   `json:"external_ns_group"`
+	StubMembers     *[]*ibclient.Memberserver `json:"stub_members,omitempty"`
+	StubMsservers   *[]*ibclient.Msdnsserver  `json:"stub_msservers,omitempty"`
+}
+
+func resourceZoneStub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceZoneStubCreate,
+		Read:   resourceZoneStubRead,
+		Update: resourceZoneStubUpdate,
+		Delete: resourceZoneStubDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceZoneStubImport,
+		},
+		CustomizeDiff: func(context context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if internalID := d.Get("internal_id"); internalID == "" || internalID == nil {
+				err := d.SetNewComputed("internal_id")
+				if err != nil {
+					return err
+				}
+			}
+			if err := dnsNameFormsCustomizeDiff(d, "fqdn"); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The name of this DNS zone. For a reverse zone, this is in 'address/cidr' format.",
+				DiffSuppressFunc: suppressDnsNameDiff,
+			},
+			"fqdn_ascii":   dnsNameAsciiSchema("fqdn"),
+			"fqdn_unicode": dnsNameUnicodeSchema("fqdn"),
+			"view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "The DNS view in which the zone is created.",
+			},
+			"zone_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "FORWARD",
+				ValidateFunc: validation.StringInSlice([]string{"FORWARD", "IPV4", "IPV6"}, false),
+				Description:  "The format of the zone. Valid values are: FORWARD, IPV4, IPV6.",
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "The RFC2317 prefix value of this DNS zone. Use this field only when" +
+					" the netmask is greater than 24 bits (IPv4 reverse zones only).",
+			},
+			"stub_from": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The primary servers (masters) of the zone, the zone's NS and SOA records are obtained from.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IP address of the primary server.",
+						},
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressDnsNameDiff,
+							Description:      "The name of the primary server.",
+						},
+					},
+				},
+			},
+			"stub_members": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The Grid members which serve the stub zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the Grid member in FQDN format.",
+						},
+					},
+				},
+			},
+			"stub_msservers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The Microsoft DNS servers which serve the stub zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The address of the Microsoft server.",
+						},
+						"ns_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name which is used in the NS record of the zone for this server.",
+						},
+						"ns_ip": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The IP address which is used in the glue record of the zone for this server.",
+						},
+						"stealth": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Determines if NS and glue records for the server are hidden.",
+						},
+						"is_master": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Determines if the server is a primary server of the zone.",
+						},
+					},
+				},
+			},
+			"ms_ad_integrated": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether Active Directory is integrated or not; valid for Microsoft-managed zones only.",
+			},
+			"ns_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A stub member name server group.",
+			},
+			"external_ns_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A forward stub server name server group.",
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if the zone is disabled or not.",
+			},
+			"disable_forwarding": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines if the name servers, which host the zone, must not forward queries for the zone's names.",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, other administrators cannot make conflicting changes.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "A descriptive comment.",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the zone stub to be added/updated, as a map in JSON format.",
+			},
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference, not to be set by a user.",
+			},
+		},
+	}
+}
+
+func convertNameServersToInterface(nameServers []ibclient.NameServer) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(nameServers))
+	for _, ns := range nameServers {
+		res = append(res, map[string]interface{}{
+			"address": ns.Address,
+			"name":    ns.Name,
+		})
+	}
+
+	return res
+}
+
+func convertStubMembersFromInterface(members []interface{}) []*ibclient.Memberserver {
+	res := make([]*ibclient.Memberserver, 0, len(members))
+	for _, member := range members {
+		res = append(res, &ibclient.Memberserver{
+			Name: member.(map[string]interface{})["name"].(string),
+		})
+	}
+
+	return res
+}
+
+func convertStubMembersToInterface(members []*ibclient.Memberserver) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(members))
+	for _, member := range members {
+		if member == nil {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"name": member.Name,
+		})
+	}
+
+	return res
+}
+
+func convertMsDnsServersFromInterface(servers []interface{}) []*ibclient.Msdnsserver {
+	res := make([]*ibclient.Msdnsserver, 0, len(servers))
+	for _, srv := range servers {
+		srvMap := srv.(map[string]interface{})
+		res = append(res, &ibclient.Msdnsserver{
+			Address:  srvMap["address"].(string),
+			NsName:   srvMap["ns_name"].(string),
+			NsIp:     srvMap["ns_ip"].(string),
+			Stealth:  srvMap["stealth"].(bool),
+			IsMaster: srvMap["is_master"].(bool),
+		})
+	}
+
+	return res
+}
+
+func convertMsDnsServersToInterface(servers []*ibclient.Msdnsserver) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(servers))
+	for _, srv := range servers {
+		if srv == nil {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"address":   srv.Address,
+			"ns_name":   srv.NsName,
+			"ns_ip":     srv.NsIp,
+			"stealth":   srv.Stealth,
+			"is_master": srv.IsMaster,
+		})
+	}
+
+	return res
+}
+
+// formZoneStub builds a WAPI object out of the resource's data,
+// with the set of fields which are allowed to be sent on an update operation.
+func formZoneStub(d *schema.ResourceData) (*zoneStub, error) {
+	stubFrom, err := validateNameServers(d.Get("stub_from").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	nsGroup := d.Get("ns_group").(string)
+	externalNsGroup := d.Get("external_ns_group").(string)
+	if len(stubFrom) == 0 && externalNsGroup == "" {
+		return nil, fmt.Errorf("either external_ns_group or stub_from must be set")
+	}
+
+	stubMembers := convertStubMembersFromInterface(d.Get("stub_members").([]interface{}))
+	stubMsservers := convertMsDnsServersFromInterface(d.Get("stub_msservers").([]interface{}))
+
+	zone := &zoneStub{
+		ZoneStub: ibclient.ZoneStub{
+			Comment:           utils.StringPtr(d.Get("comment").(string)),
+			Disable:           utils.BoolPtr(d.Get("disable").(bool)),
+			DisableForwarding: utils.BoolPtr(d.Get("disable_forwarding").(bool)),
+			Locked:            utils.BoolPtr(d.Get("locked").(bool)),
+			MsAdIntegrated:    utils.BoolPtr(d.Get("ms_ad_integrated").(bool)),
+			StubFrom:          stubFrom,
+		},
+		StubMembers:   &stubMembers,
+		StubMsservers: &stubMsservers,
+	}
+	if nsGroup != "" {
+		zone.NsGroup = &nsGroup
+	}
+	if externalNsGroup != "" {
+		zone.ExternalNsGroup = &externalNsGroup
+	}
+
+	return zone, nil
+}
+
+func setZoneStubFields(d *schema.ResourceData, zone *ibclient.ZoneStub) error {
+	if err := d.Set("fqdn", zone.Fqdn); err != nil {
+		return err
+	}
+
+	if zone.View != nil {
+		if err := d.Set("view", *zone.View); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("zone_format", zone.ZoneFormat); err != nil {
+		return err
+	}
+
+	prefix := ""
+	if zone.Prefix != nil {
+		prefix = *zone.Prefix
+	}
+	if err := d.Set("prefix", prefix); err != nil {
+		return err
+	}
+
+	if err := d.Set("stub_from", convertNameServersToInterface(zone.StubFrom)); err != nil {
+		return err
+	}
+
+	if err := d.Set("stub_members", convertStubMembersToInterface(zone.StubMembers)); err != nil {
+		return err
+	}
+
+	if err := d.Set("stub_msservers", convertMsDnsServersToInterface(zone.StubMsservers)); err != nil {
+		return err
+	}
+
+	nsGroup := ""
+	if zone.NsGroup != nil {
+		nsGroup = *zone.NsGroup
+	}
+	if err := d.Set("ns_group", nsGroup); err != nil {
+		return err
+	}
+
+	externalNsGroup := ""
+	if zone.ExternalNsGroup != nil {
+		externalNsGroup = *zone.ExternalNsGroup
+	}
+	if err := d.Set("external_ns_group", externalNsGroup); err != nil {
+		return err
+	}
+
+	comment := ""
+	if zone.Comment != nil {
+		comment = *zone.Comment
+	}
+	if err := d.Set("comment", comment); err != nil {
+		return err
+	}
+
+	for field, val := range map[string]*bool{
+		"disable":            zone.Disable,
+		"disable_forwarding": zone.DisableForwarding,
+		"locked":             zone.Locked,
+		"ms_ad_integrated":   zone.MsAdIntegrated,
+	} {
+		if err := d.Set(field, val != nil && *val); err != nil {
+			return err
+		}
+	}
+
+	return d.Set("ref", zone.Ref)
+}
+
+func resourceZoneStubCreate(d *schema.ResourceData, m interface{}) error {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+	}
+
+	zone, err := formZoneStub(d)
+	if err != nil {
+		return err
+	}
+	zone.Fqdn = dnsNameForWapi(d.Get("fqdn").(string))
+	zone.View = utils.StringPtr(d.Get("view").(string))
+	zone.ZoneFormat = d.Get("zone_format").(string)
+	if prefix := d.Get("prefix").(string); prefix != "" {
+		zone.Prefix = &prefix
+	}
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	// Generate internal ID and add it to the extensible attributes
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+	zone.Ea = extAttrs
+
+	connector := m.(ibclient.IBConnector)
+	ref, err := connector.CreateObject(zone)
+	if err != nil {
+		return fmt.Errorf("failed to create zone stub: %s", err)
+	}
+
+	d.SetId(ref)
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return err
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+
+	return resourceZoneStubRead(d, m)
+}
+
+func resourceZoneStubRead(d *schema.ResourceData, m interface{}) error {
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	var zone ibclient.ZoneStub
+	err = searchGenericObjectByRefOrInternalId(newEmptyZoneStub(), d, m, &zone)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if err = setZoneStubFields(d, &zone); err != nil {
+		return err
+	}
+
+	delete(zone.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(zone.Ea, extAttrs)
+
+	if omittedEAs != nil && len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return err
+		}
+	}
+
+	if err := setDnsNameForms(d, "fqdn"); err != nil {
+		return err
+	}
+
+	d.SetId(zone.Ref)
+
+	return nil
+}
+
+func resourceZoneStubUpdate(d *schema.ResourceData, m interface{}) error {
+	var updateSuccessful bool
+	defer func() {
+		// Reverting the state back, in case of a failure,
+		// otherwise Terraform will keep the values, which leaded to the failure,
+		// in the state file.
+		if !updateSuccessful {
+			for _, field := range []string{
+				"stub_from", "stub_members", "stub_msservers", "ms_ad_integrated",
+				"ns_group", "external_ns_group", "disable", "disable_forwarding",
+				"locked", "comment", "ext_attrs",
+			} {
+				prevVal, _ := d.GetChange(field)
+				_ = d.Set(field, prevVal)
+			}
+		}
+	}()
+
+	if d.HasChange("internal_id") {
+		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+	}
+	if d.HasChange("fqdn") {
+		return fmt.Errorf("changing the value of 'fqdn' field is not allowed")
+	}
+	if d.HasChange("view") {
+		return fmt.Errorf("changing the value of 'view' field is not allowed")
+	}
+	if d.HasChange("zone_format") {
+		return fmt.Errorf("changing the value of 'zone_format' field is not allowed")
+	}
+	if d.HasChange("prefix") {
+		return fmt.Errorf("changing the value of 'prefix' field is not allowed")
+	}
+
+	zone, err := formZoneStub(d)
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
+
+	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	var currentZone ibclient.ZoneStub
+	err = searchGenericObjectByRefOrInternalId(newEmptyZoneStub(), d, m, &currentZone)
+	if err != nil {
+		return fmt.Errorf("failed to read zone stub for update operation: %w", err)
+	}
+
+	// If 'internal_id' is not set, then generate a new one and set it to the EA.
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newInternalId := newInternalResourceIdFromString(internalId)
+	newExtAttrs[eaNameForInternalId] = newInternalId.String()
+
+	connector := m.(ibclient.IBConnector)
+	zone.Ea, err = mergeEAs(currentZone.Ea, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return err
+	}
+
+	ref, err := connector.UpdateObject(zone, currentZone.Ref)
+	if err != nil {
+		return fmt.Errorf("failed to update zone stub: %s", err)
+	}
+	updateSuccessful = true
+
+	d.SetId(ref)
+	if err = d.Set("internal_id", newInternalId.String()); err != nil {
+		return err
+	}
+	if err = d.Set("ref", ref); err != nil {
+		return err
+	}
+
+	return resourceZoneStubRead(d, m)
+}
+
+func resourceZoneStubDelete(d *schema.ResourceData, m interface{}) error {
+	var zone ibclient.ZoneStub
+	err := searchGenericObjectByRefOrInternalId(newEmptyZoneStub(), d, m, &zone)
+	if err != nil {
+		if _, ok := err.(*ibclient.NotFoundError); !ok {
+			return ibclient.NewNotFoundError(fmt.Sprintf(
+				"cannot find appropriate object on NIOS side for resource with ID '%s': %s;", d.Id(), err))
+		} else {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	connector := m.(ibclient.IBConnector)
+	if _, err = connector.DeleteObject(zone.Ref); err != nil {
+		return fmt.Errorf("failed to delete zone stub: %s", err)
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceZoneStubImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connector := m.(ibclient.IBConnector)
+
+	var zone ibclient.ZoneStub
+	if err := connector.GetObject(newEmptyZoneStub(), d.Id(), ibclient.NewQueryParams(false, nil), &zone); err != nil {
+		return nil, fmt.Errorf("failed getting zone stub: %w", err)
+	}
+
+	if err := setZoneStubFields(d, &zone); err != nil {
+		return nil, err
+	}
+
+	if zone.Ea != nil && len(zone.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(zone.Ea)
+		if err != nil {
+			return nil, err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(zone.Ref)
+
+	// Update the resource with the EA Terraform Internal ID
+	if err := resourceZoneStubUpdate(d, m); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
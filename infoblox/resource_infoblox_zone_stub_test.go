@@ -0,0 +1,186 @@
+package infoblox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"github.com/infobloxopen/infoblox-go-client/v2/utils"
+)
+
+func testAccCheckZoneStubDestroy(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "infoblox_zone_stub" {
+			continue
+		}
+		connector := meta.(ibclient.IBConnector)
+		var zone ibclient.ZoneStub
+		err := connector.GetObject(newEmptyZoneStub(), rs.Primary.ID, ibclient.NewQueryParams(false, nil), &zone)
+		if err == nil && zone.Ref != "" {
+			return fmt.Errorf("zone stub still exists")
+		}
+	}
+	return nil
+}
+
+func testAccZoneStubCompare(t *testing.T, resPath string, expectedZone *ibclient.ZoneStub) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, found := s.RootModule().Resources[resPath]
+		if !found {
+			return fmt.Errorf("not found: %s", resPath)
+		}
+		if res.Primary.Attributes["internal_id"] == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		connector := testAccProvider.Meta().(ibclient.IBConnector)
+		var zone ibclient.ZoneStub
+		err := connector.GetObject(newEmptyZoneStub(), res.Primary.Attributes["ref"], ibclient.NewQueryParams(false, nil), &zone)
+		if err != nil {
+			return err
+		}
+
+		if zone.Fqdn != expectedZone.Fqdn {
+			return fmt.Errorf("'fqdn' does not match: got '%s', expected '%s'", zone.Fqdn, expectedZone.Fqdn)
+		}
+		if *zone.View != *expectedZone.View {
+			return fmt.Errorf("'view' does not match: got '%s', expected '%s'", *zone.View, *expectedZone.View)
+		}
+
+		if len(zone.StubFrom) != len(expectedZone.StubFrom) {
+			return fmt.Errorf(
+				"the number of 'stub_from' servers does not match: got '%d', expected '%d'",
+				len(zone.StubFrom), len(expectedZone.StubFrom))
+		}
+		for i, ns := range zone.StubFrom {
+			expNs := expectedZone.StubFrom[i]
+			if ns.Name != expNs.Name || ns.Address != expNs.Address {
+				return fmt.Errorf(
+					"'stub_from' server #%d does not match: got '%s/%s', expected '%s/%s'",
+					i, ns.Name, ns.Address, expNs.Name, expNs.Address)
+			}
+		}
+
+		if expectedZone.StubMembers != nil {
+			if len(zone.StubMembers) != len(expectedZone.StubMembers) {
+				return fmt.Errorf(
+					"the number of 'stub_members' does not match: got '%d', expected '%d'",
+					len(zone.StubMembers), len(expectedZone.StubMembers))
+			}
+			for i, member := range zone.StubMembers {
+				if member.Name != expectedZone.StubMembers[i].Name {
+					return fmt.Errorf(
+						"'stub_members' member #%d does not match: got '%s', expected '%s'",
+						i, member.Name, expectedZone.StubMembers[i].Name)
+				}
+			}
+		}
+
+		if expectedZone.Comment != nil {
+			if zone.Comment == nil || *zone.Comment != *expectedZone.Comment {
+				return fmt.Errorf("'comment' does not match the expected value '%s'", *expectedZone.Comment)
+			}
+		}
+		if expectedZone.Disable != nil && *zone.Disable != *expectedZone.Disable {
+			return fmt.Errorf("'disable' does not match: got '%t', expected '%t'", *zone.Disable, *expectedZone.Disable)
+		}
+
+		return validateEAs(zone.Ea, expectedZone.Ea)
+	}
+}
+
+func TestAccResourceZoneStub(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckZoneStubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "infoblox_zone_stub" "stub" {
+						fqdn = "stub.test.com"
+						stub_from {
+							name = "ns1.partner.com"
+							address = "10.20.0.1"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneStubCompare(t, "infoblox_zone_stub.stub", &ibclient.ZoneStub{
+						Fqdn: "stub.test.com",
+						View: utils.StringPtr("default"),
+						StubFrom: []ibclient.NameServer{
+							{Name: "ns1.partner.com", Address: "10.20.0.1"},
+						},
+						Disable: utils.BoolPtr(false),
+					}),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.stub", "zone_format", "FORWARD"),
+				),
+			},
+			{
+				Config: `
+					resource "infoblox_zone_stub" "stub" {
+						fqdn = "stub.test.com"
+						stub_from {
+							name = "ns1.partner.com"
+							address = "10.20.0.1"
+						}
+						stub_from {
+							name = "ns2.partner.com"
+							address = "10.20.0.2"
+						}
+						stub_members {
+							name = "infoblox.localdomain"
+						}
+						disable = true
+						comment = "partner zone"
+						ext_attrs = jsonencode({
+							"Site" = "HQ"
+						})
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneStubCompare(t, "infoblox_zone_stub.stub", &ibclient.ZoneStub{
+						Fqdn: "stub.test.com",
+						View: utils.StringPtr("default"),
+						StubFrom: []ibclient.NameServer{
+							{Name: "ns1.partner.com", Address: "10.20.0.1"},
+							{Name: "ns2.partner.com", Address: "10.20.0.2"},
+						},
+						StubMembers: []*ibclient.Memberserver{
+							{Name: "infoblox.localdomain"},
+						},
+						Comment: utils.StringPtr("partner zone"),
+						Disable: utils.BoolPtr(true),
+						Ea:      ibclient.EA{"Site": "HQ"},
+					}),
+				),
+			},
+			// Removing the stub members from the configuration removes them from the zone.
+			{
+				Config: `
+					resource "infoblox_zone_stub" "stub" {
+						fqdn = "stub.test.com"
+						stub_from {
+							name = "ns1.partner.com"
+							address = "10.20.0.1"
+						}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccZoneStubCompare(t, "infoblox_zone_stub.stub", &ibclient.ZoneStub{
+						Fqdn: "stub.test.com",
+						View: utils.StringPtr("default"),
+						StubFrom: []ibclient.NameServer{
+							{Name: "ns1.partner.com", Address: "10.20.0.1"},
+						},
+						StubMembers: []*ibclient.Memberserver{},
+						Disable:     utils.BoolPtr(false),
+					}),
+					resource.TestCheckResourceAttr("infoblox_zone_stub.stub", "stub_members.#", "0"),
+				),
+			},
+		},
+	})
+}
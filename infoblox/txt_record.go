@@ -0,0 +1,158 @@
+package infoblox
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// txtMaxStringLen is the maximum length of a character string of a TXT record, in bytes (RFC 1035, section 3.3).
+const txtMaxStringLen = 255
+
+// splitTxtString splits the text into character strings of at most txtMaxStringLen bytes,
+// not breaking UTF-8 encoded characters.
+func splitTxtString(s string) []string {
+	if len(s) <= txtMaxStringLen {
+		return []string{s}
+	}
+
+	var res []string
+	for len(s) > txtMaxStringLen {
+		n := txtMaxStringLen
+		for n > 0 && !utf8.RuneStart(s[n]) {
+			n--
+		}
+		if n == 0 {
+			n = txtMaxStringLen
+		}
+		res = append(res, s[:n])
+		s = s[n:]
+	}
+	if s != "" {
+		res = append(res, s)
+	}
+
+	return res
+}
+
+// formTxtData forms the value of 'text' field of a TXT record's WAPI object out of its character strings.
+// A single word which fits the limit is passed as is; otherwise the strings are split to fit the limit
+// and passed as a sequence of quoted strings, which keeps their spaces and quotes.
+func formTxtData(strs []string) string {
+	if len(strs) == 1 && len(strs[0]) <= txtMaxStringLen && !strings.ContainsAny(strs[0], "\" \t") {
+		return strs[0]
+	}
+
+	quoted := make([]string, 0, len(strs))
+	for _, s := range strs {
+		for _, part := range splitTxtString(s) {
+			quoted = append(quoted, quoteCharacterString(part))
+		}
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+// parseTxtData splits the value of 'text' field of a TXT record's WAPI object into character strings.
+// NIOS returns the text either as is or as a sequence of quoted strings, like '"v=DKIM1; k=rsa; " "p=MIIB..."';
+// the text which is not such a sequence is a single string.
+func parseTxtData(text string) []string {
+	if !strings.HasPrefix(text, `"`) {
+		return []string{text}
+	}
+
+	var res []string
+	for i := 0; i < len(text); {
+		if text[i] == ' ' || text[i] == '\t' {
+			i++
+			continue
+		}
+		if text[i] != '"' {
+			return []string{text}
+		}
+
+		end := i + 1
+		for end < len(text) && text[end] != '"' {
+			if text[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(text) {
+			return []string{text}
+		}
+
+		s, err := unescapeZoneFileText(text[i+1 : end])
+		if err != nil {
+			return []string{text}
+		}
+		res = append(res, s)
+		i = end + 1
+	}
+
+	return res
+}
+
+// normalizeTxtData returns the text of a TXT record as a single string,
+// which is the concatenation of its character strings.
+func normalizeTxtData(text string) string {
+	return strings.Join(parseTxtData(text), "")
+}
+
+// suppressTxtDataDiff suppresses the difference between the forms of the same text of a TXT record,
+// like a long string and the sequence of quoted strings NIOS splits it into.
+func suppressTxtDataDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeTxtData(old) == normalizeTxtData(new)
+}
+
+// getTxtRecordData returns the value of 'text' field of the WAPI object
+// out of either 'text' or 'text_strings' field of the resource, whichever is set.
+func getTxtRecordData(d *schema.ResourceData) (string, error) {
+	useStrings := len(d.Get("text_strings").([]interface{})) > 0
+	if cfg := d.GetRawConfig(); !cfg.IsNull() {
+		useStrings = !cfg.GetAttr("text_strings").IsNull()
+	}
+
+	if !useStrings {
+		text := d.Get("text").(string)
+		if text == "" {
+			return "", fmt.Errorf("empty 'text' value is not allowed")
+		}
+		// The text which fits the limit is passed as is, the way a user has quoted it.
+		if len(text) <= txtMaxStringLen {
+			return text, nil
+		}
+		return formTxtData(parseTxtData(text)), nil
+	}
+
+	list := d.Get("text_strings").([]interface{})
+	if len(list) == 0 {
+		return "", fmt.Errorf("'text_strings' must contain at least one string")
+	}
+	strs := make([]string, 0, len(list))
+	for _, s := range list {
+		str, _ := s.(string)
+		strs = append(strs, str)
+	}
+	if len(strs) == 1 && strs[0] == "" {
+		return "", fmt.Errorf("empty 'text' value is not allowed")
+	}
+
+	return formTxtData(strs), nil
+}
+
+// setTxtRecordData sets both 'text' and 'text_strings' fields of the resource out of the WAPI object's text.
+func setTxtRecordData(d *schema.ResourceData, text *string) error {
+	var strs []string
+	if text != nil {
+		strs = parseTxtData(*text)
+	}
+
+	if err := d.Set("text", strings.Join(strs, "")); err != nil {
+		return err
+	}
+
+	return d.Set("text_strings", strs)
+}
@@ -0,0 +1,142 @@
+package infoblox
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitTxtString(t *testing.T) {
+	a255 := strings.Repeat("a", 255)
+	// 254 ASCII bytes followed by a 2-byte character, which must not be broken at the 255-byte limit.
+	a254 := strings.Repeat("a", 254)
+
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{"empty", "", []string{""}},
+		{"short", "v=spf1 -all", []string{"v=spf1 -all"}},
+		{"255 bytes", a255, []string{a255}},
+		{"256 bytes", a255 + "b", []string{a255, "b"}},
+		{"511 bytes", a255 + a255 + "c", []string{a255, a255, "c"}},
+		{"multibyte character at the limit", a254 + "é" + "x", []string{a254, "éx"}},
+		{"multibyte character within the limit", a254[1:] + "é" + "x", []string{a254[1:] + "é", "x"}},
+		{"4-byte characters", strings.Repeat("😀", 64), []string{strings.Repeat("😀", 63), "😀"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := splitTxtString(tt.text)
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Fatalf("expected %q, got %q", tt.expected, actual)
+			}
+			for _, s := range actual {
+				if len(s) > txtMaxStringLen {
+					t.Errorf("%q is longer than %d bytes", s, txtMaxStringLen)
+				}
+				if !utf8.ValidString(s) {
+					t.Errorf("%q is not valid UTF-8", s)
+				}
+			}
+			if strings.Join(actual, "") != tt.text {
+				t.Errorf("the strings do not join into the text")
+			}
+		})
+	}
+}
+
+func TestFormTxtData(t *testing.T) {
+	a255 := strings.Repeat("a", 255)
+
+	tests := []struct {
+		name     string
+		strs     []string
+		expected string
+	}{
+		{"single word", []string{"token"}, "token"},
+		{"spaces", []string{"v=spf1 -all"}, `"v=spf1 -all"`},
+		{"quotes", []string{`say "hi"`}, `"say \"hi\""`},
+		{"backslash in a word", []string{`a\b`}, `a\b`},
+		{"backslash and spaces", []string{`a\b c`}, `"a\\b c"`},
+		{"several strings", []string{"part1", "part 2"}, `"part1" "part 2"`},
+		{"255 bytes", []string{a255}, a255},
+		{"256 bytes", []string{a255 + "b"}, `"` + a255 + `" "b"`},
+		{"several long strings", []string{a255 + "b", "c"}, `"` + a255 + `" "b" "c"`},
+	}
+
+	for _, tt := range tests {
+		if actual := formTxtData(tt.strs); actual != tt.expected {
+			t.Errorf("%s: formTxtData(%q) = %q, expected %q", tt.name, tt.strs, actual, tt.expected)
+		}
+	}
+}
+
+func TestParseTxtData(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{"token", []string{"token"}},
+		{"v=spf1 -all", []string{"v=spf1 -all"}},
+		{`"v=spf1 -all"`, []string{"v=spf1 -all"}},
+		{`"part1" "part 2"`, []string{"part1", "part 2"}},
+		{`"say \"hi\""`, []string{`say "hi"`}},
+		{`"a\\b"`, []string{`a\b`}},
+		{`"caf\195\169"`, []string{"café"}},
+		// The texts which are not sequences of quoted strings are single strings.
+		{`"unterminated`, []string{`"unterminated`}},
+		{`"quoted" word`, []string{`"quoted" word`}},
+		{`"" ""`, []string{"", ""}},
+	}
+
+	for _, tt := range tests {
+		if actual := parseTxtData(tt.text); !reflect.DeepEqual(actual, tt.expected) {
+			t.Errorf("parseTxtData(%q) = %q, expected %q", tt.text, actual, tt.expected)
+		}
+	}
+}
+
+func TestTxtDataRoundTrip(t *testing.T) {
+	texts := []string{
+		"token",
+		`say "hi" \o/`,
+		strings.Repeat("a", 300),
+		strings.Repeat("é", 200),
+		strings.Repeat("ab\"c\\", 100),
+	}
+
+	for _, text := range texts {
+		data := formTxtData([]string{text})
+		if actual := strings.Join(parseTxtData(data), ""); actual != text {
+			t.Errorf("the text %q is formed into %q, which is parsed into %q", text, data, actual)
+		}
+	}
+}
+
+func TestSuppressTxtDataDiff(t *testing.T) {
+	long := strings.Repeat("a", 300)
+
+	tests := []struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		{"token", "token", true},
+		{`"v=spf1 -all"`, "v=spf1 -all", true},
+		{`"` + long[:255] + `" "` + long[255:] + `"`, long, true},
+		{`"part1" "part2"`, "part1part2", true},
+		{`"say \"hi\""`, `say "hi"`, true},
+		{`"a\\b"`, `a\b`, true},
+		{"token", "other", false},
+		{`"part1" "part2"`, "part1 part2", false},
+	}
+
+	for _, tt := range tests {
+		if actual := suppressTxtDataDiff("text", tt.old, tt.new, nil); actual != tt.expected {
+			t.Errorf("suppressTxtDataDiff(%q, %q) = %t, expected %t", tt.old, tt.new, actual, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// wapiRawRequest is a pseudo-object which allows sending requests
+// the go-client has no direct support for (WAPI function calls, for example)
+// through the regular IBConnector.CreateObject() method.
+// The response of such a request is returned by CreateObject() as is,
+// in JSON format, instead of an object reference.
+type wapiRawRequest struct {
+	ibclient.IBBase
+
+	// path is either a WAPI object type or an object reference.
+	path string
+
+	// function is the name of a WAPI function to call, if any.
+	function string
+
+	// body is the content of the request which is sent in JSON format.
+	body interface{}
+}
+
+func (r *wapiRawRequest) ObjectType() string {
+	return r.path
+}
+
+func (r *wapiRawRequest) MarshalJSON() ([]byte, error) {
+	if r.body == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(r.body)
+}
+
+type wapiRawResultKey struct{}
+
+// wapiRequestBuilder extends the go-client's request builder with support for wapiRawRequest objects.
+type wapiRequestBuilder struct {
+	ibclient.WapiRequestBuilder
+}
+
+func (b *wapiRequestBuilder) BuildRequest(
+	t ibclient.RequestType, obj ibclient.IBObject, ref string, queryParams *ibclient.QueryParams) (*http.Request, error) {
+
+	req, err := b.WapiRequestBuilder.BuildRequest(t, obj, ref, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	rawReq, ok := obj.(*wapiRawRequest)
+	if !ok {
+		return req, nil
+	}
+
+	if rawReq.function != "" {
+		q := req.URL.Query()
+		q.Set("_function", rawReq.function)
+		req.URL.RawQuery = q.Encode()
+	}
+
+	return req.WithContext(context.WithValue(req.Context(), wapiRawResultKey{}, true)), nil
+}
+
+// wapiHttpRequestor wraps the responses to wapiRawRequest objects into JSON strings,
+// this way they pass through IBConnector.CreateObject() unchanged.
+type wapiHttpRequestor struct {
+	ibclient.WapiHttpRequestor
+}
+
+func (r *wapiHttpRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	res, err := r.WapiHttpRequestor.SendRequest(req)
+	if err != nil || req.Context().Value(wapiRawResultKey{}) == nil {
+		return res, err
+	}
+
+	return json.Marshal(string(res))
+}
+
+// callWapiFunction calls the WAPI function 'function' of the object with the reference 'ref'
+// (or of the object type, if 'ref' is a type name); the result, if any, is stored into 'res'.
+func callWapiFunction(connector ibclient.IBConnector, ref string, function string, args interface{}, res interface{}) error {
+	result, err := connector.CreateObject(&wapiRawRequest{path: ref, function: function, body: args})
+	if err != nil {
+		return fmt.Errorf("failed to call function '%s' of '%s': %w", function, ref, err)
+	}
+
+	if res == nil || result == "" {
+		return nil
+	}
+
+	if err = json.Unmarshal([]byte(result), res); err != nil {
+		return fmt.Errorf("failed to parse the result of function '%s': %w", function, err)
+	}
+
+	return nil
+}
+
+// wapiObject is a generic WAPI object, for reading objects of any type into JSON maps.
+type wapiObject struct {
+	ibclient.IBBase
+
+	objectType string
+}
+
+func newWapiObject(objectType string, returnFields []string) *wapiObject {
+	obj := &wapiObject{objectType: objectType}
+	obj.SetReturnFields(returnFields)
+
+	return obj
+}
+
+func (o *wapiObject) ObjectType() string {
+	return o.objectType
+}
+
+// wapiPageSize is the maximum number of objects requested at a time by getObjectsPaged().
+const wapiPageSize = 1000
+
+// wapiPage is a page of the objects NIOS returns for a paged search.
+type wapiPage struct {
+	Result     json.RawMessage `json:"result"`
+	NextPageId string          `json:"next_page_id"`
+}
+
+// getObjectsPaged searches for the objects of the type of 'obj' using WAPI paging,
+// which is required for the searches that may return more objects than NIOS returns at once.
+// 'handle' is called for every page with the objects of the page in JSON format.
+func getObjectsPaged(
+	connector ibclient.IBConnector, obj ibclient.IBObject, sf map[string]string, handle func(objects json.RawMessage) error) error {
+
+	pageId := ""
+	for {
+		pageSf := make(map[string]string, len(sf)+4)
+		for k, v := range sf {
+			pageSf[k] = v
+		}
+		pageSf["_paging"] = "1"
+		pageSf["_return_as_object"] = "1"
+		pageSf["_max_results"] = strconv.Itoa(wapiPageSize)
+		if pageId != "" {
+			pageSf["_page_id"] = pageId
+		}
+
+		var page wapiPage
+		if err := connector.GetObject(obj, "", ibclient.NewQueryParams(false, pageSf), &page); err != nil {
+			return fmt.Errorf("failed to get objects of type '%s': %w", obj.ObjectType(), err)
+		}
+		if len(page.Result) > 0 {
+			if err := handle(page.Result); err != nil {
+				return err
+			}
+		}
+
+		if page.NextPageId == "" {
+			return nil
+		}
+		pageId = page.NextPageId
+	}
+}
+
+const multiRequestObjType = "request"
+
+// wapiRequestItem is one of the requests of a WAPI multiple-object request;
+// 'object' is either an object type (for POST requests) or an object reference.
+// The fields of a request's result may be assigned to the state variables of the multiple-object request,
+// to be substituted in the following requests as '##STATE:<variable>:##'.
+type wapiRequestItem struct {
+	Method             string            `json:"method"`
+	Object             string            `json:"object"`
+	Data               interface{}       `json:"data,omitempty"`
+	Args               map[string]string `json:"args,omitempty"`
+	AssignState        map[string]string `json:"assign_state,omitempty"`
+	EnableSubstitution bool              `json:"enable_substitution,omitempty"`
+}
+
+// multiRequest sends the requests to NIOS as a single multiple-object request,
+// NIOS processes them in one transaction: either all of them succeed or none.
+// The results of the requests are returned in the order of the requests.
+func multiRequest(connector ibclient.IBConnector, items []wapiRequestItem) ([]json.RawMessage, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	result, err := connector.CreateObject(&wapiRawRequest{path: multiRequestObjType, body: items})
+	if err != nil {
+		return nil, fmt.Errorf("multiple-object request failed: %w", err)
+	}
+
+	var results []json.RawMessage
+	if err = json.Unmarshal([]byte(result), &results); err != nil {
+		return nil, fmt.Errorf("failed to parse the result of multiple-object request: %w", err)
+	}
+	if len(results) != len(items) {
+		return nil, fmt.Errorf("multiple-object request returned %d results for %d requests", len(results), len(items))
+	}
+
+	return results, nil
+}
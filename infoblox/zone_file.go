@@ -0,0 +1,447 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+const (
+	zoneFileClass      = "IN"
+	zoneFileApexName   = "@"
+	zoneFileSoaType    = "SOA"
+	allRecordsObjType  = "allrecords"
+	wapiRecordTypeStub = "record:"
+)
+
+// zoneRecordType describes how the RDATA of a DNS record type is obtained from NIOS:
+// 'objType' is the WAPI object type of the records and 'fields' are the fields of the object
+// which the RDATA is formed of, in the order of the master file format.
+type zoneRecordType struct {
+	objType string
+	fields  []string
+	// names lists the fields which are domain names, they are made absolute in the master file.
+	names []string
+}
+
+// zoneRecordTypes lists the record types which are exported as master file records,
+// by the type names NIOS reports in 'allrecords' objects.
+var zoneRecordTypes = map[string]zoneRecordType{
+	"A":     {objType: "record:a", fields: []string{"ipv4addr"}},
+	"AAAA":  {objType: "record:aaaa", fields: []string{"ipv6addr"}},
+	"CAA":   {objType: "record:caa", fields: []string{"ca_flag", "ca_tag", "ca_value"}},
+	"CNAME": {objType: "record:cname", fields: []string{"canonical"}, names: []string{"canonical"}},
+	"DNAME": {objType: "record:dname", fields: []string{"target"}, names: []string{"target"}},
+	"MX": {objType: "record:mx", fields: []string{"preference", "mail_exchanger"},
+		names: []string{"mail_exchanger"}},
+	"NAPTR": {objType: "record:naptr",
+		fields: []string{"order", "preference", "flags", "services", "regexp", "replacement"},
+		names:  []string{"replacement"}},
+	"NS":  {objType: "record:ns", fields: []string{"nameserver"}, names: []string{"nameserver"}},
+	"PTR": {objType: "record:ptr", fields: []string{"ptrdname"}, names: []string{"ptrdname"}},
+	"SRV": {objType: "record:srv", fields: []string{"priority", "weight", "port", "target"},
+		names: []string{"target"}},
+	"TXT": {objType: "record:txt", fields: []string{"text"}},
+}
+
+// zoneRecordQuotedFields lists the fields which are character strings in the master file format.
+var zoneRecordQuotedFields = map[string]bool{
+	"ca_value": true,
+	"flags":    true,
+	"services": true,
+	"regexp":   true,
+}
+
+// zoneFileRecord is a DNS record of a zone, as it appears in a master file.
+type zoneFileRecord struct {
+	name     string
+	fqdn     string
+	rrType   string
+	ttl      uint32
+	useTtl   bool
+	rdata    string
+	ref      string
+	disabled bool
+	comment  string
+}
+
+// String returns the record as a line of a master file; disabled records are commented out.
+func (r *zoneFileRecord) String() string {
+	ttl := ""
+	if r.useTtl {
+		ttl = fmt.Sprintf("%d", r.ttl)
+	}
+
+	line := fmt.Sprintf("%s\t%s\t%s\t%s\t%s", r.name, ttl, zoneFileClass, r.rrType, r.rdata)
+	if r.comment != "" {
+		line = fmt.Sprintf("%s ; %s", line, strings.ReplaceAll(r.comment, "\n", " "))
+	}
+	if r.disabled {
+		line = "; (disabled) " + line
+	}
+
+	return line
+}
+
+// zoneFile is the content of an authoritative zone in the form of an RFC 1035 master file.
+type zoneFile struct {
+	origin     string
+	view       string
+	defaultTtl uint32
+	records    []*zoneFileRecord
+	// unsupported lists the records of the types which cannot be exported, in the form '<name> <type>'.
+	unsupported []string
+}
+
+// String returns the master file text.
+func (z *zoneFile) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "; zone '%s', DNS view '%s'\n", strings.TrimSuffix(z.origin, "."), z.view)
+	fmt.Fprintf(&b, "$ORIGIN %s\n", z.origin)
+	fmt.Fprintf(&b, "$TTL %d\n", z.defaultTtl)
+	for _, rec := range z.records {
+		b.WriteString(rec.String())
+		b.WriteString("\n")
+	}
+	for _, rec := range z.unsupported {
+		fmt.Fprintf(&b, "; unsupported record: %s\n", rec)
+	}
+
+	return b.String()
+}
+
+// absoluteDnsName makes a domain name, as NIOS returns it, absolute.
+func absoluteDnsName(name string) string {
+	if name == "" || name == "." {
+		return "."
+	}
+	return strings.TrimSuffix(name, ".") + "."
+}
+
+// quoteCharacterString returns a character string in the master file format.
+func quoteCharacterString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// quoteTxtData returns the text of a TXT record in the master file format: a sequence of quoted
+// character strings, the long ones being split to fit the limit of the format.
+func quoteTxtData(text string) string {
+	data := formTxtData(parseTxtData(text))
+	if !strings.HasPrefix(data, `"`) {
+		return quoteCharacterString(data)
+	}
+	return data
+}
+
+// zoneRecordRdata forms the RDATA of the record out of the fields of the WAPI object.
+func zoneRecordRdata(rrType string, obj map[string]interface{}) (string, error) {
+	t, found := zoneRecordTypes[rrType]
+	if !found {
+		return "", fmt.Errorf("record type '%s' is not supported", rrType)
+	}
+
+	names := make(map[string]bool, len(t.names))
+	for _, n := range t.names {
+		names[n] = true
+	}
+
+	values := make([]string, 0, len(t.fields))
+	for _, f := range t.fields {
+		var value string
+		switch v := obj[f].(type) {
+		case string:
+			value = v
+		case float64:
+			value = fmt.Sprintf("%d", int64(v))
+		case nil:
+			value = ""
+		default:
+			value = fmt.Sprintf("%v", v)
+		}
+
+		switch {
+		case rrType == "TXT":
+			value = quoteTxtData(value)
+		case names[f]:
+			value = absoluteDnsName(value)
+		case zoneRecordQuotedFields[f]:
+			value = quoteCharacterString(value)
+		}
+		values = append(values, value)
+	}
+
+	return strings.Join(values, " "), nil
+}
+
+// allRecord is a record of 'allrecords' WAPI object.
+type allRecord struct {
+	ibclient.Allrecords
+	Ttl *uint32 `json:"ttl,omitempty"`
+}
+
+// zoneRecordTypeName converts the type of a record, as 'allrecords' reports it, to the name of the DNS record type;
+// host records' addresses are reported as A and AAAA records.
+func zoneRecordTypeName(t string) string {
+	t = strings.TrimPrefix(strings.ToLower(t), wapiRecordTypeStub)
+	switch t {
+	case "host_ipv4addr":
+		return "A"
+	case "host_ipv6addr":
+		return "AAAA"
+	}
+	return strings.ToUpper(t)
+}
+
+// getZoneRecords reads all the records of the zone, using 'allrecords' WAPI object.
+func getZoneRecords(connector ibclient.IBConnector, zone string, view string, sf map[string]string) ([]allRecord, error) {
+	search := map[string]string{
+		"zone": zone,
+		"view": view,
+	}
+	for k, v := range sf {
+		search[k] = v
+	}
+
+	obj := newWapiObject(allRecordsObjType, []string{
+		"name", "type", "ttl", "record", "address", "disable", "comment", "zone", "view",
+	})
+
+	var records []allRecord
+	err := getObjectsPaged(connector, obj, search, func(objects json.RawMessage) error {
+		var page []allRecord
+		if err := json.Unmarshal(objects, &page); err != nil {
+			return err
+		}
+		records = append(records, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// zoneRecordData is the data of a record which 'allrecords' WAPI object does not report.
+type zoneRecordData struct {
+	rdata string
+	ea    ibclient.EA
+}
+
+// getZoneRecordsData reads the RDATA and, optionally, the extensible attributes of the zone's records
+// of the given type, by the records' references.
+func getZoneRecordsData(
+	connector ibclient.IBConnector, zone string, view string, rrType string, withEAs bool) (map[string]*zoneRecordData, error) {
+
+	t := zoneRecordTypes[rrType]
+	fields := t.fields
+	if withEAs {
+		fields = append(append([]string{}, t.fields...), "extattrs")
+	}
+	obj := newWapiObject(t.objType, fields)
+	search := map[string]string{
+		"zone": zone,
+		"view": view,
+	}
+
+	data := make(map[string]*zoneRecordData)
+	err := getObjectsPaged(connector, obj, search, func(objects json.RawMessage) error {
+		var page []map[string]interface{}
+		if err := json.Unmarshal(objects, &page); err != nil {
+			return err
+		}
+		for _, rec := range page {
+			ref, _ := rec["_ref"].(string)
+			value, err := zoneRecordRdata(rrType, rec)
+			if err != nil {
+				return err
+			}
+			recData := &zoneRecordData{rdata: value}
+			if withEAs {
+				var eaObj struct {
+					Ea ibclient.EA `json:"extattrs"`
+				}
+				if err = convertWapiObject(rec, &eaObj); err != nil {
+					return err
+				}
+				recData.ea = eaObj.Ea
+			}
+			data[ref] = recData
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// getAllRecordsData reads the data of the records, which 'allrecords' WAPI object reports, per record type;
+// only the types which the records are of are read. Host records' addresses are skipped, as their data is known.
+func getAllRecordsData(
+	connector ibclient.IBConnector, zone string, view string, records []allRecord, withEAs bool) (map[string]*zoneRecordData, error) {
+
+	data := make(map[string]*zoneRecordData)
+	types := make(map[string]bool)
+	for _, rec := range records {
+		rrType := zoneRecordTypeName(rec.Type)
+		if _, supported := zoneRecordTypes[rrType]; !supported || types[rrType] || rec.Address != "" {
+			continue
+		}
+		types[rrType] = true
+		typeData, err := getZoneRecordsData(connector, zone, view, rrType, withEAs)
+		if err != nil {
+			return nil, err
+		}
+		for ref, v := range typeData {
+			data[ref] = v
+		}
+	}
+
+	return data, nil
+}
+
+// zoneSoaRecord forms the SOA record of the zone out of the zone's settings.
+func zoneSoaRecord(zone *zoneAuth) *zoneFileRecord {
+	mname := ""
+	switch {
+	case len(zone.MemberSoaMnames) > 0 && zone.MemberSoaMnames[0] != nil:
+		mname = zone.MemberSoaMnames[0].Mname
+	case zone.GridPrimary != nil && len(*zone.GridPrimary) > 0 && (*zone.GridPrimary)[0] != nil:
+		mname = (*zone.GridPrimary)[0].Name
+	case zone.ExternalPrimaries != nil && len(*zone.ExternalPrimaries) > 0:
+		mname = (*zone.ExternalPrimaries)[0].Name
+	default:
+		mname = zone.Fqdn
+	}
+
+	rname := "hostmaster." + zone.Fqdn
+	if zone.SoaEmail != nil && *zone.SoaEmail != "" {
+		rname = *zone.SoaEmail
+		if at := strings.Index(rname, "@"); at >= 0 {
+			rname = strings.ReplaceAll(rname[:at], ".", `\.`) + "." + rname[at+1:]
+		}
+	}
+
+	value := func(v *uint32) uint32 {
+		if v == nil {
+			return 0
+		}
+		return *v
+	}
+
+	return &zoneFileRecord{
+		name:   zoneFileApexName,
+		fqdn:   absoluteDnsName(zone.Fqdn),
+		rrType: zoneFileSoaType,
+		ttl:    value(zone.SoaDefaultTtl),
+		rdata: fmt.Sprintf("%s %s %d %d %d %d %d", absoluteDnsName(mname), absoluteDnsName(rname),
+			value(zone.SoaSerialNumber), value(zone.SoaRefresh), value(zone.SoaRetry),
+			value(zone.SoaExpire), value(zone.SoaNegativeTtl)),
+	}
+}
+
+// exportZoneFile reads the authoritative zone with all its records from NIOS.
+func exportZoneFile(connector ibclient.IBConnector, fqdn string, view string) (*zoneFile, error) {
+	zoneObj := &ibclient.ZoneAuth{}
+	zoneObj.SetReturnFields([]string{
+		"fqdn", "view", "soa_default_ttl", "soa_email", "soa_expire", "soa_negative_ttl",
+		"soa_refresh", "soa_retry", "soa_serial_number", "member_soa_mnames", "grid_primary",
+		"external_primaries",
+	})
+	var zones []zoneAuth
+	sf := map[string]string{
+		"fqdn": fqdn,
+		"view": view,
+	}
+	err := connector.GetObject(zoneObj, "", ibclient.NewQueryParams(false, sf), &zones)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get zone '%s' in DNS view '%s': %w", fqdn, view, err)
+	}
+	if len(zones) == 0 {
+		return nil, fmt.Errorf("zone '%s' not found in DNS view '%s'", fqdn, view)
+	}
+	zone := &zones[0]
+
+	records, err := getZoneRecords(connector, zone.Fqdn, view, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rdata, err := getAllRecordsData(connector, zone.Fqdn, view, records, false)
+	if err != nil {
+		return nil, err
+	}
+
+	soa := zoneSoaRecord(zone)
+	res := &zoneFile{
+		origin:     absoluteDnsName(zone.Fqdn),
+		view:       view,
+		defaultTtl: soa.ttl,
+		records:    []*zoneFileRecord{soa},
+	}
+
+	for _, rec := range records {
+		rrType := zoneRecordTypeName(rec.Type)
+		name := rec.Name
+		if name == "" {
+			name = zoneFileApexName
+		}
+		fqdn := res.origin
+		if name != zoneFileApexName {
+			fqdn = name + "." + res.origin
+		}
+
+		var value string
+		switch {
+		case rec.Address != "" && (rrType == "A" || rrType == "AAAA"):
+			value = rec.Address
+		default:
+			recData, found := rdata[rec.Record]
+			if !found {
+				res.unsupported = append(res.unsupported, fmt.Sprintf("%s %s", name, strings.ToLower(rec.Type)))
+				continue
+			}
+			value = recData.rdata
+		}
+
+		zr := &zoneFileRecord{
+			name:     name,
+			fqdn:     fqdn,
+			rrType:   rrType,
+			ttl:      res.defaultTtl,
+			rdata:    value,
+			ref:      rec.Record,
+			disabled: rec.Disable,
+			comment:  rec.Comment,
+		}
+		if rec.Ttl != nil {
+			zr.ttl = *rec.Ttl
+			zr.useTtl = true
+		}
+		res.records = append(res.records, zr)
+	}
+
+	// The SOA record goes first, the other records are ordered to make the result stable.
+	sort.SliceStable(res.records[1:], func(i, j int) bool {
+		a, b := res.records[i+1], res.records[j+1]
+		if a.name != b.name {
+			if a.name == zoneFileApexName || b.name == zoneFileApexName {
+				return a.name == zoneFileApexName
+			}
+			return a.name < b.name
+		}
+		if a.rrType != b.rrType {
+			return a.rrType < b.rrType
+		}
+		return a.rdata < b.rdata
+	})
+	sort.Strings(res.unsupported)
+
+	return res, nil
+}
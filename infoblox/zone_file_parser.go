@@ -0,0 +1,446 @@
+package infoblox
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// zoneFileToken is a field of a master file line; quoted fields are character strings.
+type zoneFileToken struct {
+	text   string
+	quoted bool
+}
+
+// zoneFileLine is a logical line of a master file: parentheses may join several lines into one.
+type zoneFileLine struct {
+	number int
+	// inheritOwner is true if the line starts with a blank, the owner of the previous record is used then.
+	inheritOwner bool
+	tokens       []zoneFileToken
+}
+
+// zoneFileNumericFields lists the fields of WAPI record objects which are numbers.
+var zoneFileNumericFields = map[string]bool{
+	"preference": true,
+	"priority":   true,
+	"weight":     true,
+	"port":       true,
+	"order":      true,
+	"ca_flag":    true,
+}
+
+// zoneFileClasses lists the DNS classes which may appear in a master file.
+var zoneFileClasses = map[string]bool{
+	"IN": true,
+	"CH": true,
+	"CS": true,
+	"HS": true,
+}
+
+// zoneFileOutOfZoneError is the error of a record of a master file the owner of which does not belong to the zone.
+type zoneFileOutOfZoneError struct {
+	line  int
+	owner string
+	zone  string
+}
+
+func (e *zoneFileOutOfZoneError) Error() string {
+	return fmt.Sprintf("line %d: '%s' does not belong to zone '%s'", e.line, e.owner, e.zone)
+}
+
+// parsedZoneRecord is a record of a master file, converted into the WAPI object it is created as.
+type parsedZoneRecord struct {
+	zoneFileRecord
+	objType string
+	data    map[string]interface{}
+}
+
+// key identifies the record by its content.
+func (r *parsedZoneRecord) key() string {
+	return zoneRecordKey(r.fqdn, r.rrType, r.useTtl, r.ttl, r.rdata)
+}
+
+func zoneRecordKey(fqdn, rrType string, useTtl bool, ttl uint32, rdata string) string {
+	ttlStr := "-"
+	if useTtl {
+		ttlStr = strconv.FormatUint(uint64(ttl), 10)
+	}
+	return strings.Join([]string{strings.ToLower(absoluteDnsName(fqdn)), rrType, ttlStr, rdata}, " ")
+}
+
+// splitZoneFile splits a master file into logical lines and their fields,
+// comments are removed and the escapes are kept as they are.
+func splitZoneFile(content string) ([]*zoneFileLine, error) {
+	var (
+		lines  []*zoneFileLine
+		cur    *zoneFileLine
+		depth  int
+		start  int
+		token  strings.Builder
+		inTok  bool
+		quoted bool
+	)
+
+	flush := func() {
+		if inTok {
+			cur.tokens = append(cur.tokens, zoneFileToken{text: token.String(), quoted: quoted})
+		}
+		token.Reset()
+		inTok, quoted = false, false
+	}
+
+	for n, physical := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if depth == 0 {
+			cur = &zoneFileLine{
+				number:       n + 1,
+				inheritOwner: len(physical) > 0 && (physical[0] == ' ' || physical[0] == '\t'),
+			}
+			start = n + 1
+		}
+
+		inQuote := false
+	scan:
+		for i := 0; i < len(physical); i++ {
+			c := physical[i]
+			switch {
+			case inQuote && c == '\\' && i+1 < len(physical):
+				token.WriteByte(c)
+				token.WriteByte(physical[i+1])
+				i++
+			case inQuote && c == '"':
+				inQuote = false
+				flush()
+			case inQuote:
+				token.WriteByte(c)
+			case c == ';':
+				break scan
+			case c == '"':
+				flush()
+				inQuote, inTok, quoted = true, true, true
+			case c == '(':
+				flush()
+				depth++
+			case c == ')':
+				flush()
+				if depth == 0 {
+					return nil, fmt.Errorf("line %d: unbalanced ')'", n+1)
+				}
+				depth--
+			case c == ' ' || c == '\t':
+				flush()
+			case c == '\\' && i+1 < len(physical):
+				token.WriteByte(c)
+				token.WriteByte(physical[i+1])
+				inTok = true
+				i++
+			default:
+				token.WriteByte(c)
+				inTok = true
+			}
+		}
+		if inQuote {
+			return nil, fmt.Errorf("line %d: unterminated character string", n+1)
+		}
+		flush()
+
+		if depth == 0 && len(cur.tokens) > 0 {
+			lines = append(lines, cur)
+		}
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced '('", start)
+	}
+
+	return lines, nil
+}
+
+// unescapeZoneFileText replaces the escapes ('\X' and '\DDD') of a master file field with the characters.
+func unescapeZoneFileText(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+1 >= len(s) {
+			return "", fmt.Errorf("trailing '\\' in '%s'", s)
+		}
+		if i+3 < len(s) && isDigits(s[i+1:i+4]) {
+			n, _ := strconv.Atoi(s[i+1 : i+4])
+			if n > 255 {
+				return "", fmt.Errorf("invalid escape '\\%s' in '%s'", s[i+1:i+4], s)
+			}
+			b.WriteByte(byte(n))
+			i += 3
+			continue
+		}
+		b.WriteByte(s[i+1])
+		i++
+	}
+
+	return b.String(), nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// parseZoneFileTtl parses a TTL, which is either a number of seconds or a BIND-style duration like '1h30m'.
+func parseZoneFileTtl(s string) (uint32, error) {
+	if n, err := strconv.ParseUint(s, 10, 32); err == nil {
+		return uint32(n), nil
+	}
+
+	units := map[byte]uint64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	var total, cur uint64
+	digits := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= '0' && c <= '9' {
+			cur = cur*10 + uint64(c-'0')
+			digits = true
+			continue
+		}
+		unit, found := units[strings.ToLower(string(c))[0]]
+		if !found || !digits {
+			return 0, fmt.Errorf("invalid TTL '%s'", s)
+		}
+		total += cur * unit
+		cur, digits = 0, false
+	}
+	if digits {
+		return 0, fmt.Errorf("invalid TTL '%s'", s)
+	}
+	if total > 0xFFFFFFFF {
+		return 0, fmt.Errorf("TTL '%s' is out of range", s)
+	}
+
+	return uint32(total), nil
+}
+
+// resolveZoneFileName makes a domain name of a master file absolute.
+func resolveZoneFileName(name string, origin string) (string, error) {
+	name, err := unescapeZoneFileText(name)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case name == zoneFileApexName:
+		return origin, nil
+	case strings.HasSuffix(name, "."):
+		return name, nil
+	case origin == ".":
+		return name + ".", nil
+	default:
+		return name + "." + origin, nil
+	}
+}
+
+// isSubdomain determines whether 'name' is 'zone' or one of its subdomains, both names must be absolute.
+func isSubdomain(name string, zone string) bool {
+	name, zone = strings.ToLower(name), strings.ToLower(zone)
+	return name == zone || zone == "." || strings.HasSuffix(name, "."+zone)
+}
+
+// parseZoneFile parses the master file of the zone 'zone' and converts its records into WAPI objects
+// of the DNS view 'view'. The records which cannot be created this way are skipped and reported in 'skipped';
+// the syntax errors, the records out of the zone and the unsupported directives are errors.
+func parseZoneFile(content string, zone string, view string) (records []*parsedZoneRecord, skipped []string, err error) {
+	lines, err := splitZoneFile(content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zone = absoluteDnsName(zone)
+	origin := zone
+	var (
+		owner      string
+		defaultTtl *uint32
+		lastTtl    *uint32
+	)
+
+	for _, line := range lines {
+		tokens := line.tokens
+
+		if !line.inheritOwner && !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+			directive := strings.ToUpper(tokens[0].text)
+			switch {
+			case directive == "$ORIGIN" && len(tokens) >= 2:
+				if origin, err = resolveZoneFileName(tokens[1].text, origin); err != nil {
+					return nil, nil, fmt.Errorf("line %d: %w", line.number, err)
+				}
+			case directive == "$TTL" && len(tokens) >= 2:
+				ttl, err := parseZoneFileTtl(tokens[1].text)
+				if err != nil {
+					return nil, nil, fmt.Errorf("line %d: %w", line.number, err)
+				}
+				defaultTtl = &ttl
+			default:
+				return nil, nil, fmt.Errorf("line %d: directive '%s' is not supported", line.number, tokens[0].text)
+			}
+			continue
+		}
+
+		if !line.inheritOwner {
+			if owner, err = resolveZoneFileName(tokens[0].text, origin); err != nil {
+				return nil, nil, fmt.Errorf("line %d: %w", line.number, err)
+			}
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, nil, fmt.Errorf("line %d: the record has no owner name", line.number)
+		}
+		if !isSubdomain(owner, zone) {
+			return nil, nil, &zoneFileOutOfZoneError{line: line.number, owner: owner, zone: zone}
+		}
+
+		var ttl *uint32
+		for len(tokens) > 0 && !tokens[0].quoted {
+			class := strings.ToUpper(tokens[0].text)
+			if zoneFileClasses[class] {
+				if class != zoneFileClass {
+					return nil, nil, fmt.Errorf("line %d: class '%s' is not supported", line.number, tokens[0].text)
+				}
+				tokens = tokens[1:]
+				continue
+			}
+			if ttl != nil {
+				break
+			}
+			value, err := parseZoneFileTtl(tokens[0].text)
+			if err != nil {
+				break
+			}
+			ttl = &value
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 {
+			return nil, nil, fmt.Errorf("line %d: record type is missing", line.number)
+		}
+
+		switch {
+		case ttl != nil:
+			lastTtl = ttl
+		case defaultTtl != nil:
+			ttl = defaultTtl
+		default:
+			ttl = lastTtl
+		}
+
+		rrType := strings.ToUpper(tokens[0].text)
+		rec, reason, err := convertZoneFileRecord(owner, rrType, tokens[1:], origin, zone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+		if rec == nil {
+			skipped = append(skipped, fmt.Sprintf("line %d: %s record of '%s' is skipped: %s",
+				line.number, rrType, owner, reason))
+			continue
+		}
+
+		rec.data["view"] = view
+		if ttl != nil {
+			rec.ttl, rec.useTtl = *ttl, true
+			rec.data["ttl"] = *ttl
+			rec.data["use_ttl"] = true
+		}
+		records = append(records, rec)
+	}
+
+	return records, skipped, nil
+}
+
+// convertZoneFileRecord converts a record of a master file into a WAPI object; if the record is not supported,
+// nil is returned together with the reason.
+func convertZoneFileRecord(
+	owner string, rrType string, rdata []zoneFileToken, origin string, zone string) (*parsedZoneRecord, string, error) {
+
+	switch rrType {
+	case zoneFileSoaType:
+		return nil, "the SOA record of the zone is managed by NIOS", nil
+	case "NS":
+		if strings.EqualFold(owner, zone) {
+			return nil, "the name servers of the zone are defined by the zone's settings", nil
+		}
+		return nil, "delegations are managed by 'infoblox_zone_delegated' resources", nil
+	}
+
+	t, found := zoneRecordTypes[rrType]
+	if !found {
+		return nil, "the record type is not supported", nil
+	}
+
+	names := make(map[string]bool, len(t.names))
+	for _, n := range t.names {
+		names[n] = true
+	}
+
+	data := map[string]interface{}{
+		"name": strings.TrimSuffix(owner, "."),
+	}
+	if rrType == "TXT" {
+		if len(rdata) == 0 {
+			return nil, "", fmt.Errorf("TXT record must have at least one character string")
+		}
+		texts := make([]string, 0, len(rdata))
+		for _, tok := range rdata {
+			text, err := unescapeZoneFileText(tok.text)
+			if err != nil {
+				return nil, "", err
+			}
+			texts = append(texts, text)
+		}
+		data["text"] = formTxtData(texts)
+	} else {
+		if len(rdata) != len(t.fields) {
+			return nil, "", fmt.Errorf("%s record must have %d fields of data, got %d", rrType, len(t.fields), len(rdata))
+		}
+		for i, f := range t.fields {
+			switch {
+			case names[f]:
+				name, err := resolveZoneFileName(rdata[i].text, origin)
+				if err != nil {
+					return nil, "", err
+				}
+				data[f] = strings.TrimSuffix(name, ".")
+			case zoneFileNumericFields[f]:
+				n, err := strconv.ParseUint(rdata[i].text, 10, 16)
+				if err != nil {
+					return nil, "", fmt.Errorf("invalid value '%s' of %s record's field '%s'", rdata[i].text, rrType, f)
+				}
+				data[f] = n
+			default:
+				text, err := unescapeZoneFileText(rdata[i].text)
+				if err != nil {
+					return nil, "", err
+				}
+				data[f] = text
+			}
+		}
+	}
+
+	value, err := zoneRecordRdata(rrType, data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &parsedZoneRecord{
+		zoneFileRecord: zoneFileRecord{
+			name:   owner,
+			fqdn:   owner,
+			rrType: rrType,
+			rdata:  value,
+		},
+		objType: t.objType,
+		data:    data,
+	}, "", nil
+}
@@ -0,0 +1,261 @@
+package infoblox
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitZoneFile(t *testing.T) {
+	type line struct {
+		number       int
+		inheritOwner bool
+		tokens       []string
+	}
+	tests := []struct {
+		name     string
+		content  string
+		expected []line
+		err      string
+	}{
+		{
+			name:     "single line",
+			content:  "www 3600 IN A 10.0.0.1",
+			expected: []line{{1, false, []string{"www", "3600", "IN", "A", "10.0.0.1"}}},
+		},
+		{
+			name: "multi-line parentheses with comments",
+			content: "@ IN SOA ns1 admin (\n" +
+				"    1 ; serial\n" +
+				"    3600 ) ; refresh\n" +
+				"www A 10.0.0.1",
+			expected: []line{
+				{1, false, []string{"@", "IN", "SOA", "ns1", "admin", "1", "3600"}},
+				{4, false, []string{"www", "A", "10.0.0.1"}},
+			},
+		},
+		{
+			name:    "owner inheritance, blank and comment lines",
+			content: "www A 10.0.0.1\n\n; comment\n\tAAAA 2001:db8::1\r\n  A 10.0.0.2",
+			expected: []line{
+				{1, false, []string{"www", "A", "10.0.0.1"}},
+				{4, true, []string{"AAAA", "2001:db8::1"}},
+				{5, true, []string{"A", "10.0.0.2"}},
+			},
+		},
+		{
+			name:     "character strings keep their escapes, spaces and semicolons",
+			content:  `txt TXT "a; b" "c\"d" e\ f`,
+			expected: []line{{1, false, []string{"txt", "TXT", "a; b", `c\"d`, `e\ f`}}},
+		},
+		{
+			name:    "unbalanced closing parenthesis",
+			content: "www A 10.0.0.1 )",
+			err:     "line 1: unbalanced ')'",
+		},
+		{
+			name:    "unbalanced opening parenthesis",
+			content: "www A 10.0.0.1\n@ SOA ns1 admin (\n 1 2 3 4 5",
+			err:     "line 2: unbalanced '('",
+		},
+		{
+			name:    "unterminated character string",
+			content: "www A 10.0.0.1\ntxt TXT \"abc",
+			err:     "line 2: unterminated character string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := splitZoneFile(tt.content)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("expected error %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			actual := make([]line, 0, len(lines))
+			for _, l := range lines {
+				tokens := make([]string, 0, len(l.tokens))
+				for _, tok := range l.tokens {
+					tokens = append(tokens, tok.text)
+				}
+				actual = append(actual, line{l.number, l.inheritOwner, tokens})
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestUnescapeZoneFileText(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+		err      bool
+	}{
+		{text: "abc", expected: "abc"},
+		{text: `a\.b`, expected: "a.b"},
+		{text: `\065BC`, expected: "ABC"},
+		{text: `\0651`, expected: "A1"},
+		{text: `\\`, expected: `\`},
+		{text: `\"quoted\"`, expected: `"quoted"`},
+		{text: `\195\169`, expected: "é"},
+		{text: `\256`, err: true},
+		{text: `abc\`, err: true},
+	}
+
+	for _, tt := range tests {
+		actual, err := unescapeZoneFileText(tt.text)
+		if tt.err {
+			if err == nil {
+				t.Errorf("unescapeZoneFileText(%q): expected an error, got %q", tt.text, actual)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unescapeZoneFileText(%q): unexpected error: %s", tt.text, err)
+		} else if actual != tt.expected {
+			t.Errorf("unescapeZoneFileText(%q) = %q, expected %q", tt.text, actual, tt.expected)
+		}
+	}
+}
+
+func TestParseZoneFileTtl(t *testing.T) {
+	tests := []struct {
+		ttl      string
+		expected uint32
+		err      bool
+	}{
+		{ttl: "0", expected: 0},
+		{ttl: "3600", expected: 3600},
+		{ttl: "4294967295", expected: 4294967295},
+		{ttl: "30s", expected: 30},
+		{ttl: "1h", expected: 3600},
+		{ttl: "1h30m", expected: 5400},
+		{ttl: "1W2D", expected: 777600},
+		{ttl: "1d1s", expected: 86401},
+		{ttl: "4294967296", err: true},
+		{ttl: "50000d", err: true},
+		{ttl: "h", err: true},
+		{ttl: "1x", err: true},
+		{ttl: "10m5", err: true},
+		{ttl: "IN", err: true},
+	}
+
+	for _, tt := range tests {
+		actual, err := parseZoneFileTtl(tt.ttl)
+		if tt.err {
+			if err == nil {
+				t.Errorf("parseZoneFileTtl(%q): expected an error, got %d", tt.ttl, actual)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseZoneFileTtl(%q): unexpected error: %s", tt.ttl, err)
+		} else if actual != tt.expected {
+			t.Errorf("parseZoneFileTtl(%q) = %d, expected %d", tt.ttl, actual, tt.expected)
+		}
+	}
+}
+
+func TestParseZoneFile(t *testing.T) {
+	content := `$TTL 1h
+@    IN SOA ns1 admin ( 1 3600 600
+                        86400 300 )
+@    IN NS  ns1
+www  300 IN A 10.0.0.1
+     IN AAAA 2001:db8::1
+mail IN MX ( 10
+             mx1 )
+txt  IN TXT "hello world" "\"second\""
+alias CNAME www.example.com.
+$ORIGIN Sub.Example.com.
+host A 10.0.0.2
+info HINFO "PC" "Linux"
+`
+	records, skipped, err := parseZoneFile(content, "example.com.", "default")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	type record struct {
+		objType string
+		ttl     uint32
+		data    map[string]interface{}
+	}
+	expected := []record{
+		{"record:a", 300, map[string]interface{}{"name": "www.example.com", "ipv4addr": "10.0.0.1"}},
+		{"record:aaaa", 3600, map[string]interface{}{"name": "www.example.com", "ipv6addr": "2001:db8::1"}},
+		{"record:mx", 3600, map[string]interface{}{"name": "mail.example.com", "preference": uint64(10), "mail_exchanger": "mx1.example.com"}},
+		{"record:txt", 3600, map[string]interface{}{"name": "txt.example.com", "text": `"hello world" "\"second\""`}},
+		{"record:cname", 3600, map[string]interface{}{"name": "alias.example.com", "canonical": "www.example.com"}},
+		{"record:a", 3600, map[string]interface{}{"name": "host.Sub.Example.com", "ipv4addr": "10.0.0.2"}},
+	}
+	if len(records) != len(expected) {
+		t.Fatalf("expected %d records, got %d", len(expected), len(records))
+	}
+	for i, rec := range records {
+		exp := expected[i]
+		exp.data["view"] = "default"
+		exp.data["ttl"] = exp.ttl
+		exp.data["use_ttl"] = true
+		if rec.objType != exp.objType || !rec.useTtl || rec.ttl != exp.ttl || !reflect.DeepEqual(rec.data, exp.data) {
+			t.Errorf("record %d: expected %s %d %v, got %s %d %v", i, exp.objType, exp.ttl, exp.data, rec.objType, rec.ttl, rec.data)
+		}
+	}
+
+	if len(skipped) != 3 {
+		t.Fatalf("expected 3 skipped records, got %v", skipped)
+	}
+	for i, rrType := range []string{"SOA", "NS", "HINFO"} {
+		if !strings.Contains(skipped[i], rrType+" record") {
+			t.Errorf("expected skipped record %d to be %s, got %q", i, rrType, skipped[i])
+		}
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{"no owner", "  A 10.0.0.1", "line 1: the record has no owner name"},
+		{"unsupported directive", "$INCLUDE other.zone", "line 1: directive '$INCLUDE' is not supported"},
+		{"unsupported class", "www CH A 10.0.0.1", "line 1: class 'CH' is not supported"},
+		{"missing record type", "www 3600 IN", "line 1: record type is missing"},
+		{"wrong number of fields", "mail MX 10", "line 1: MX record must have 2 fields of data, got 1"},
+		{"invalid number", "mail MX ten mx1", "line 1: invalid value 'ten' of MX record's field 'preference'"},
+		{"invalid TTL directive", "$TTL 1x", "line 1: invalid TTL '1x'"},
+		{"unbalanced quotes", `txt TXT "abc`, "line 1: unterminated character string"},
+		{"unbalanced parentheses", "mail MX ( 10 mx1", "line 1: unbalanced '('"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := parseZoneFile(tt.content, "example.com", "default")
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("expected error %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestParseZoneFileOutOfZone(t *testing.T) {
+	_, _, err := parseZoneFile("www A 10.0.0.1\nwww.example.org. A 10.0.0.2", "example.com", "default")
+
+	var outOfZone *zoneFileOutOfZoneError
+	if !errors.As(err, &outOfZone) {
+		t.Fatalf("expected an out-of-zone error, got %v", err)
+	}
+	if outOfZone.line != 2 || outOfZone.owner != "www.example.org." {
+		t.Errorf("unexpected out-of-zone error: %s", err)
+	}
+}
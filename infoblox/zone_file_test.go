@@ -0,0 +1,51 @@
+package infoblox
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestQuoteTxtData(t *testing.T) {
+	a255 := strings.Repeat("a", 255)
+
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{"", `""`},
+		{"token", `"token"`},
+		{"v=spf1 -all", `"v=spf1 -all"`},
+		{`a;b\c`, `"a;b\\c"`},
+		{`"part1" "part 2"`, `"part1" "part 2"`},
+		{`"say \"hi\""`, `"say \"hi\""`},
+		{a255, `"` + a255 + `"`},
+		{a255 + "b", `"` + a255 + `" "b"`},
+		{`"` + a255 + "b" + `"`, `"` + a255 + `" "b"`},
+	}
+
+	for _, tt := range tests {
+		if actual := quoteTxtData(tt.text); actual != tt.expected {
+			t.Errorf("quoteTxtData(%q) = %q, expected %q", tt.text, actual, tt.expected)
+		}
+	}
+}
+
+func TestZoneFileTxtRoundTrip(t *testing.T) {
+	text := strings.Repeat("x", 300) + ` "quoted" \`
+	rdata, err := zoneRecordRdata("TXT", map[string]interface{}{"text": text})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The exported record is imported again as the same text.
+	records, _, err := parseZoneFile("txt IN TXT "+rdata, "example.com", "default")
+	if err != nil {
+		t.Fatalf("the exported record %q cannot be parsed: %s", rdata, err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	if actual := normalizeTxtData(records[0].data["text"].(string)); actual != text {
+		t.Errorf("expected text %q, got %q", text, actual)
+	}
+}